@@ -0,0 +1,158 @@
+package godelin
+
+import (
+	"errors"
+	"slices"
+)
+
+type Accumulator[T, R any] interface {
+	Add(T)
+	Result() R
+	Merge(Accumulator[T, R])
+	Reset()
+}
+
+func Accumulate[T, R any](slice []T, accumulator Accumulator[T, R]) R {
+	for _, element := range slice {
+		accumulator.Add(element)
+	}
+	return accumulator.Result()
+}
+
+func GroupByAccumulate[T any, K comparable, R any](
+	slice []T,
+	keySelector func(T) K,
+	newAccumulator func() Accumulator[T, R],
+) map[K]R {
+	accumulators := make(map[K]Accumulator[T, R])
+	for _, element := range slice {
+		accumulator, _ := GetOrPut(accumulators, keySelector(element), func(K) Accumulator[T, R] {
+			return newAccumulator()
+		})
+		accumulator.Add(element)
+	}
+	result := make(map[K]R, len(accumulators))
+	for key, accumulator := range accumulators {
+		result[key] = accumulator.Result()
+	}
+	return result
+}
+
+// AccumulateParallel splits slice into one contiguous part per worker, accumulates the parts
+// concurrently and merges the partial results in slice order. A panicking worker is reported
+// as a *PanicError instead of crashing the process.
+func AccumulateParallel[T, R any](slice []T, workers int, newAccumulator func() Accumulator[T, R]) (R, error) {
+	if workers <= 0 {
+		panic("AccumulateParallel: workers must be positive")
+	}
+	partSize := max(1, (len(slice)+workers-1)/workers)
+	parts := slices.Collect(slices.Chunk(slice, partSize))
+	partials := make([]Accumulator[T, R], len(parts))
+	done := make([]<-chan error, len(parts))
+	for i, part := range parts {
+		partials[i] = newAccumulator()
+		done[i] = SafeGo(func() { Accumulate(part, partials[i]) })
+	}
+	errs := Map(done, func(ch <-chan error) error { return <-ch })
+	result := newAccumulator()
+	if err := errors.Join(errs...); err != nil {
+		var zero R
+		return zero, err
+	}
+	for _, partial := range partials {
+		result.Merge(partial)
+	}
+	return result.Result(), nil
+}
+
+type SumAccumulator[T Number] struct {
+	sum T
+}
+
+func NewSumAccumulator[T Number]() *SumAccumulator[T] {
+	return &SumAccumulator[T]{}
+}
+
+func (a *SumAccumulator[T]) Add(value T) {
+	a.sum += value
+}
+
+func (a *SumAccumulator[T]) Result() T {
+	return a.sum
+}
+
+func (a *SumAccumulator[T]) Merge(other Accumulator[T, T]) {
+	a.sum += other.Result()
+}
+
+func (a *SumAccumulator[T]) Reset() {
+	a.sum = 0
+}
+
+// TopKAccumulator keeps the k greatest elements under less, greatest first.
+type TopKAccumulator[T any] struct {
+	k    int
+	less func(T, T) bool
+	top  []T
+}
+
+func NewTopKAccumulator[T any](k int, less func(T, T) bool) *TopKAccumulator[T] {
+	if k < 0 {
+		panic("NewTopKAccumulator: k must not be negative")
+	}
+	return &TopKAccumulator[T]{k: k, less: less, top: make([]T, 0, k)}
+}
+
+func (a *TopKAccumulator[T]) Add(value T) {
+	index, _ := slices.BinarySearchFunc(a.top, value, func(existing, target T) int {
+		if a.less(target, existing) {
+			return -1
+		}
+		return 1
+	})
+	if index >= a.k {
+		return
+	}
+	if len(a.top) == a.k {
+		a.top = a.top[:len(a.top)-1]
+	}
+	a.top = slices.Insert(a.top, index, value)
+}
+
+func (a *TopKAccumulator[T]) Result() []T {
+	return slices.Clone(a.top)
+}
+
+func (a *TopKAccumulator[T]) Merge(other Accumulator[T, []T]) {
+	ForEach(other.Result(), a.Add)
+}
+
+func (a *TopKAccumulator[T]) Reset() {
+	a.top = a.top[:0]
+}
+
+type HistogramAccumulator[T comparable] struct {
+	counts map[T]int
+}
+
+func NewHistogramAccumulator[T comparable]() *HistogramAccumulator[T] {
+	return &HistogramAccumulator[T]{counts: make(map[T]int)}
+}
+
+func (a *HistogramAccumulator[T]) Add(value T) {
+	a.counts[value]++
+}
+
+func (a *HistogramAccumulator[T]) Result() map[T]int {
+	return MapEntries(a.counts, func(key T, count int) (T, int) { return key, count })
+}
+
+func (a *HistogramAccumulator[T]) Merge(other Accumulator[T, map[T]int]) {
+	for value, count := range other.Result() {
+		a.counts[value] += count
+	}
+}
+
+func (a *HistogramAccumulator[T]) Reset() {
+	clear(a.counts)
+}
@@ -0,0 +1,114 @@
+package godelin
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestAccumulateSum(t *testing.T) {
+	if actual := Accumulate([]int{1, 2, 3, 4}, NewSumAccumulator[int]()); actual != 10 {
+		t.Errorf("Accumulate() = %v, expected 10", actual)
+	}
+}
+
+func TestTopKAccumulator(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	testCases := []struct {
+		name     string
+		k        int
+		input    []int
+		expected []int
+	}{
+		{name: "three largest", k: 3, input: []int{5, 1, 9, 3, 7, 9, 2}, expected: []int{9, 9, 7}},
+		{name: "fewer elements than k", k: 5, input: []int{2, 1}, expected: []int{2, 1}},
+		{name: "k of zero", k: 0, input: []int{1, 2}, expected: []int{}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			actual := Accumulate(testCase.input, NewTopKAccumulator(testCase.k, less))
+			if !reflect.DeepEqual(actual, testCase.expected) {
+				t.Errorf("TopKAccumulator = %v, expected %v", actual, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestHistogramAccumulator(t *testing.T) {
+	actual := Accumulate([]string{"a", "b", "a"}, NewHistogramAccumulator[string]())
+	expected := map[string]int{"a": 2, "b": 1}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("HistogramAccumulator = %v, expected %v", actual, expected)
+	}
+}
+
+func TestAccumulatorMergeAndReset(t *testing.T) {
+	left, right := NewHistogramAccumulator[string](), NewHistogramAccumulator[string]()
+	Accumulate([]string{"a", "b"}, left)
+	Accumulate([]string{"b", "c"}, right)
+	left.Merge(right)
+	if expected := map[string]int{"a": 1, "b": 2, "c": 1}; !reflect.DeepEqual(left.Result(), expected) {
+		t.Errorf("Merge() = %v, expected %v", left.Result(), expected)
+	}
+	left.Reset()
+	if len(left.Result()) != 0 {
+		t.Errorf("Reset() left %v behind", left.Result())
+	}
+
+	top := NewTopKAccumulator(2, func(a, b int) bool { return a < b })
+	Accumulate([]int{1, 5}, top)
+	other := NewTopKAccumulator(2, func(a, b int) bool { return a < b })
+	Accumulate([]int{3, 4}, other)
+	top.Merge(other)
+	if expected := []int{5, 4}; !reflect.DeepEqual(top.Result(), expected) {
+		t.Errorf("Merge() = %v, expected %v", top.Result(), expected)
+	}
+}
+
+func TestGroupByAccumulate(t *testing.T) {
+	actual := GroupByAccumulate([]int{1, 2, 3, 4, 5}, func(n int) bool { return n%2 == 0 }, func() Accumulator[int, int] {
+		return NewSumAccumulator[int]()
+	})
+	expected := map[bool]int{false: 9, true: 6}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("GroupByAccumulate() = %v, expected %v", actual, expected)
+	}
+}
+
+func TestAccumulateParallel(t *testing.T) {
+	input := make([]int, 1000)
+	for i := range input {
+		input[i] = i
+	}
+	for _, workers := range []int{1, 3, 8, 2000} {
+		actual, err := AccumulateParallel(input, workers, func() Accumulator[int, []int] {
+			return NewTopKAccumulator(3, func(a, b int) bool { return a < b })
+		})
+		if err != nil || !reflect.DeepEqual(actual, []int{999, 998, 997}) {
+			t.Errorf("AccumulateParallel(workers=%d) = %v, %v, expected [999 998 997], nil", workers, actual, err)
+		}
+	}
+	if actual, err := AccumulateParallel([]int{}, 4, func() Accumulator[int, int] { return NewSumAccumulator[int]() }); actual != 0 || err != nil {
+		t.Errorf("AccumulateParallel() on empty input = %v, %v, expected 0, nil", actual, err)
+	}
+}
+
+type panickingAccumulator struct{ SumAccumulator[int] }
+
+func (a *panickingAccumulator) Add(value int) {
+	if value == 13 {
+		panic("unlucky")
+	}
+	a.SumAccumulator.Add(value)
+}
+
+func TestAccumulateParallelRecoversPanics(t *testing.T) {
+	_, err := AccumulateParallel([]int{1, 13, 2, 3}, 2, func() Accumulator[int, int] {
+		return &panickingAccumulator{}
+	})
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) || panicErr.Value != "unlucky" {
+		t.Errorf("AccumulateParallel() error = %v, expected a *PanicError", err)
+	}
+}
@@ -0,0 +1,162 @@
+package godelin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+var (
+	ErrOffsetTruncated  = errors.New("offset is no longer retained")
+	ErrOffsetOutOfRange = errors.New("offset is beyond the end of the log")
+)
+
+type LogRecord[T any] struct {
+	Offset int64
+	Value  T
+}
+
+// AppendLog is an in-memory, offset-addressed log that keeps at most retention records
+// (all of them when retention is zero or less). It is safe for concurrent use.
+type AppendLog[T any] struct {
+	mu          sync.Mutex
+	records     []T
+	firstOffset int64
+	retention   int
+	appended    chan struct{}
+	closed      bool
+}
+
+func NewAppendLog[T any](retention int) *AppendLog[T] {
+	return &AppendLog[T]{retention: retention, appended: make(chan struct{})}
+}
+
+// Append adds values to the log and returns the offset of the first one.
+func (l *AppendLog[T]) Append(values ...T) int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		panic("AppendLog: append to closed log")
+	}
+	offset := l.firstOffset + int64(len(l.records))
+	l.records = append(l.records, values...)
+	if excess := len(l.records) - l.retention; l.retention > 0 && excess > 0 {
+		clear(l.records[:excess])
+		l.records = l.records[excess:]
+		l.firstOffset += int64(excess)
+	}
+	if len(values) > 0 {
+		close(l.appended)
+		l.appended = make(chan struct{})
+	}
+	return offset
+}
+
+func (l *AppendLog[T]) FirstOffset() int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.firstOffset
+}
+
+func (l *AppendLog[T]) NextOffset() int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.firstOffset + int64(len(l.records))
+}
+
+// ReadFrom returns up to max records starting at offset; reading at NextOffset returns none.
+func (l *AppendLog[T]) ReadFrom(offset int64, max int) ([]LogRecord[T], error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	records, _, err := l.readLocked(offset, max)
+	return records, err
+}
+
+func (l *AppendLog[T]) readLocked(offset int64, max int) ([]LogRecord[T], <-chan struct{}, error) {
+	next := l.firstOffset + int64(len(l.records))
+	switch {
+	case offset < l.firstOffset:
+		return nil, nil, fmt.Errorf("read at %d: %w (first retained offset is %d)", offset, ErrOffsetTruncated, l.firstOffset)
+	case offset > next:
+		return nil, nil, fmt.Errorf("read at %d: %w (next offset is %d)", offset, ErrOffsetOutOfRange, next)
+	}
+	start := int(offset - l.firstOffset)
+	end := len(l.records)
+	if max > 0 {
+		end = min(end, start+max)
+	}
+	records := make([]LogRecord[T], 0, end-start)
+	for i := start; i < end; i++ {
+		records = append(records, LogRecord[T]{Offset: l.firstOffset + int64(i), Value: l.records[i]})
+	}
+	return records, l.appended, nil
+}
+
+// Close stops accepting appends; subscribers receive the remaining records and then finish.
+func (l *AppendLog[T]) Close() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if !l.closed {
+		l.closed = true
+		close(l.appended)
+	}
+}
+
+type LogSubscription[T any] struct {
+	C    <-chan LogRecord[T]
+	done chan struct{}
+	err  error
+}
+
+// Err reports why the subscription ended: nil after Close, the context's error after
+// cancellation, or ErrOffsetTruncated when the subscriber fell behind the retention window.
+// It blocks until C is closed.
+func (s *LogSubscription[T]) Err() error {
+	<-s.done
+	return s.err
+}
+
+func (l *AppendLog[T]) Subscribe(ctx context.Context, fromOffset int64) *LogSubscription[T] {
+	records := make(chan LogRecord[T])
+	subscription := &LogSubscription[T]{C: records, done: make(chan struct{})}
+	go func() {
+		defer close(subscription.done)
+		defer close(records)
+		subscription.err = callSafely(func() error {
+			return l.deliver(ctx, fromOffset, records)
+		})
+	}()
+	return subscription
+}
+
+func (l *AppendLog[T]) deliver(ctx context.Context, offset int64, out chan<- LogRecord[T]) error {
+	for {
+		l.mu.Lock()
+		batch, appended, err := l.readLocked(offset, 0)
+		closed := l.closed
+		l.mu.Unlock()
+		if err != nil {
+			return err
+		}
+		for _, record := range batch {
+			select {
+			case out <- record:
+				offset = record.Offset + 1
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if len(batch) > 0 {
+			continue
+		}
+		if closed {
+			return nil
+		}
+		select {
+		case <-appended:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
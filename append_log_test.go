@@ -0,0 +1,117 @@
+package godelin
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAppendLogReadFrom(t *testing.T) {
+	log := NewAppendLog[string](0)
+	if offset := log.Append("a", "b"); offset != 0 {
+		t.Errorf("Append() = %d, expected 0", offset)
+	}
+	if offset := log.Append("c"); offset != 2 {
+		t.Errorf("Append() = %d, expected 2", offset)
+	}
+	tests := []struct {
+		name     string
+		offset   int64
+		max      int
+		expected []LogRecord[string]
+	}{
+		{"from start", 0, 0, []LogRecord[string]{{0, "a"}, {1, "b"}, {2, "c"}}},
+		{"bounded", 1, 1, []LogRecord[string]{{1, "b"}}},
+		{"at end", 3, 10, []LogRecord[string]{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := log.ReadFrom(tt.offset, tt.max)
+			if err != nil || !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("ReadFrom(%d, %d) = %v, %v, expected %v", tt.offset, tt.max, result, err, tt.expected)
+			}
+		})
+	}
+	if _, err := log.ReadFrom(4, 1); !errors.Is(err, ErrOffsetOutOfRange) {
+		t.Errorf("ReadFrom(4, 1) error = %v, expected %v", err, ErrOffsetOutOfRange)
+	}
+}
+
+func TestAppendLogRetention(t *testing.T) {
+	log := NewAppendLog[int](2)
+	log.Append(1, 2, 3)
+	log.Append(4)
+	if first, next := log.FirstOffset(), log.NextOffset(); first != 2 || next != 4 {
+		t.Errorf("offsets = %d..%d, expected 2..4", first, next)
+	}
+	if _, err := log.ReadFrom(1, 0); !errors.Is(err, ErrOffsetTruncated) {
+		t.Errorf("ReadFrom(1, 0) error = %v, expected %v", err, ErrOffsetTruncated)
+	}
+	result, _ := log.ReadFrom(2, 0)
+	if expected := []LogRecord[int]{{2, 3}, {3, 4}}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("ReadFrom(2, 0) = %v, expected %v", result, expected)
+	}
+}
+
+func TestAppendLogSubscribe(t *testing.T) {
+	log := NewAppendLog[int](0)
+	log.Append(1, 2)
+	subscription := log.Subscribe(context.Background(), 1)
+	go func() {
+		log.Append(3, 4)
+		log.Close()
+	}()
+	received := []LogRecord[int]{}
+	for record := range subscription.C {
+		received = append(received, record)
+	}
+	if expected := []LogRecord[int]{{1, 2}, {2, 3}, {3, 4}}; !reflect.DeepEqual(received, expected) {
+		t.Errorf("Subscribe(1) received %v, expected %v", received, expected)
+	}
+	if err := subscription.Err(); err != nil {
+		t.Errorf("Err() = %v, expected nil", err)
+	}
+}
+
+func TestAppendLogSubscribeCancel(t *testing.T) {
+	log := NewAppendLog[int](0)
+	ctx, cancel := context.WithCancel(context.Background())
+	subscription := log.Subscribe(ctx, 0)
+	cancel()
+	select {
+	case _, open := <-subscription.C:
+		if open {
+			t.Fatalf("Subscribe() delivered a record from an empty log")
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("subscription did not stop after cancellation")
+	}
+	if err := subscription.Err(); !errors.Is(err, context.Canceled) {
+		t.Errorf("Err() = %v, expected %v", err, context.Canceled)
+	}
+}
+
+func TestAppendLogSubscribeFallsBehind(t *testing.T) {
+	log := NewAppendLog[int](1)
+	log.Append(1, 2)
+	subscription := log.Subscribe(context.Background(), 0)
+	for range subscription.C {
+		t.Errorf("Subscribe() delivered a record past the retention window")
+	}
+	if err := subscription.Err(); !errors.Is(err, ErrOffsetTruncated) {
+		t.Errorf("Err() = %v, expected %v", err, ErrOffsetTruncated)
+	}
+}
+
+func TestAppendLogAppendAfterClose(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Append() after Close() did not panic")
+		}
+	}()
+	log := NewAppendLog[int](0)
+	log.Close()
+	log.Append(1)
+}
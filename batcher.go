@@ -0,0 +1,159 @@
+package godelin
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Batcher collects values and hands them to flush in batches of size, or earlier once the
+// oldest pending value has waited maxDelay. It is safe for concurrent use. flush is called one
+// batch at a time in the order the values were added, without the Batcher's lock held.
+// Completed batches go to a queue that one goroutine at a time drains; an Add that finds
+// another goroutine draining leaves its batches to it and returns, so Add never waits for a
+// flush running elsewhere. flush may call Add, but not Flush or Close, which wait for the
+// queue to drain and so would wait for the flush they are called from.
+//
+// A panic in flush is returned as a *PanicError by the call that ran the flush; for a flush
+// run by maxDelay or on behalf of another call, by the next Add, Flush or Close.
+type Batcher[T any] struct {
+	mu         sync.Mutex
+	drained    *sync.Cond
+	size       int
+	maxDelay   time.Duration
+	flush      func([]T)
+	pending    []T
+	queue      [][]T
+	draining   bool
+	queued     uint64
+	flushed    uint64
+	timer      *time.Timer
+	generation uint64
+	closed     bool
+	err        error
+}
+
+func NewBatcher[T any](size int, maxDelay time.Duration, flush func([]T)) *Batcher[T] {
+	if size <= 0 || maxDelay <= 0 {
+		panic("NewBatcher: size and maxDelay must be positive")
+	}
+	b := &Batcher[T]{size: size, maxDelay: maxDelay, flush: flush}
+	b.drained = sync.NewCond(&b.mu)
+	return b
+}
+
+func (b *Batcher[T]) Add(values ...T) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		panic("Batcher: add to closed batcher")
+	}
+	b.pending = append(b.pending, values...)
+	full := len(b.pending) / b.size * b.size
+	if full > 0 {
+		b.enqueue(slices.Collect(slices.Chunk(b.pending[:full], b.size))...)
+		b.pending = slices.Clone(b.pending[full:])
+		b.resetTimer()
+	}
+	if b.timer == nil && len(b.pending) > 0 {
+		b.startTimer()
+	}
+	if !b.draining {
+		b.drain()
+	}
+	return b.takeErr()
+}
+
+// Flush hands the pending values to flush immediately, if there are any, and waits until
+// every batch queued so far has been flushed.
+func (b *Batcher[T]) Flush() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.flushAllLocked()
+	return b.takeErr()
+}
+
+// Close flushes the pending values and stops the Batcher; further calls to Add panic.
+func (b *Batcher[T]) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	b.flushAllLocked()
+	return b.takeErr()
+}
+
+func (b *Batcher[T]) flushAllLocked() {
+	b.enqueue(b.pending)
+	b.pending = nil
+	b.resetTimer()
+	if !b.draining {
+		b.drain()
+	}
+	for target := b.queued; b.flushed < target; {
+		b.drained.Wait()
+	}
+}
+
+func (b *Batcher[T]) enqueue(batches ...[]T) {
+	for _, batch := range batches {
+		if len(batch) > 0 {
+			b.queue = append(b.queue, batch)
+			b.queued++
+		}
+	}
+}
+
+// drain must be called with mu held and no other goroutine draining. It releases mu around
+// each call to flush, so other calls can queue batches meanwhile; those are flushed too.
+func (b *Batcher[T]) drain() {
+	b.draining = true
+	for len(b.queue) > 0 {
+		batch := b.queue[0]
+		b.queue[0] = nil
+		b.queue = b.queue[1:]
+		b.mu.Unlock()
+		err := callSafely(func() error {
+			b.flush(batch)
+			return nil
+		})
+		b.mu.Lock()
+		b.err = errors.Join(b.err, err)
+		b.flushed++
+		b.drained.Broadcast()
+	}
+	b.queue = nil
+	b.draining = false
+}
+
+func (b *Batcher[T]) takeErr() error {
+	err := b.err
+	b.err = nil
+	return err
+}
+
+func (b *Batcher[T]) startTimer() {
+	generation := b.generation
+	b.timer = time.AfterFunc(b.maxDelay, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		// a flush since the timer started has already taken the values it was guarding
+		if b.generation != generation {
+			return
+		}
+		b.enqueue(b.pending)
+		b.pending = nil
+		b.resetTimer()
+		if !b.draining {
+			b.drain()
+		}
+	})
+}
+
+func (b *Batcher[T]) resetTimer() {
+	b.generation++
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+}
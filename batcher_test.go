@@ -0,0 +1,213 @@
+package godelin
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+type recordedBatches struct {
+	mu      sync.Mutex
+	batches [][]int
+}
+
+func (r *recordedBatches) flush(batch []int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.batches = append(r.batches, batch)
+}
+
+func (r *recordedBatches) get() [][]int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.batches)
+}
+
+func TestBatcherFlushesFullBatches(t *testing.T) {
+	var recorded recordedBatches
+	batcher := NewBatcher(3, time.Hour, recorded.flush)
+	batcher.Add(1, 2)
+	if got := recorded.get(); len(got) != 0 {
+		t.Errorf("batches before size reached = %v, expected none", got)
+	}
+	batcher.Add(3, 4, 5, 6, 7, 8, 9, 10)
+	if got, expected := recorded.get(), [][]int{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("batches = %v, expected %v", got, expected)
+	}
+	batcher.Close()
+	if got, expected := recorded.get(), [][]int{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}, {10}}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("batches after Close = %v, expected %v", got, expected)
+	}
+}
+
+func TestBatcherFlushesAfterMaxDelay(t *testing.T) {
+	var recorded recordedBatches
+	batcher := NewBatcher(100, 20*time.Millisecond, recorded.flush)
+	defer batcher.Close()
+	batcher.Add(1)
+	batcher.Add(2)
+	deadline := time.Now().Add(time.Second)
+	for len(recorded.get()) == 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if got, expected := recorded.get(), [][]int{{1, 2}}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("batches after maxDelay = %v, expected %v", got, expected)
+	}
+}
+
+func TestBatcherManualFlushCancelsTimer(t *testing.T) {
+	var recorded recordedBatches
+	batcher := NewBatcher(100, 20*time.Millisecond, recorded.flush)
+	batcher.Add(1)
+	batcher.Flush()
+	batcher.Flush()
+	time.Sleep(50 * time.Millisecond)
+	if got, expected := recorded.get(), [][]int{{1}}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("batches = %v, expected %v", got, expected)
+	}
+}
+
+func TestBatcherConcurrentAdds(t *testing.T) {
+	var recorded recordedBatches
+	batcher := NewBatcher(7, time.Millisecond, recorded.flush)
+	var wg sync.WaitGroup
+	for worker := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 100 {
+				batcher.Add(worker*100 + i)
+			}
+		}()
+	}
+	wg.Wait()
+	batcher.Close()
+
+	seen := make(map[int]bool)
+	for _, batch := range recorded.get() {
+		if len(batch) == 0 || len(batch) > 7 {
+			t.Errorf("batch size = %d, expected 1..7", len(batch))
+		}
+		for _, value := range batch {
+			seen[value] = true
+		}
+	}
+	if len(seen) != 800 {
+		t.Errorf("flushed %d distinct values, expected 800", len(seen))
+	}
+}
+
+func TestBatcherAddAfterClosePanics(t *testing.T) {
+	batcher := NewBatcher(2, time.Second, func([]int) {})
+	batcher.Close()
+	batcher.Close()
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected a panic but did not get one")
+		}
+	}()
+	batcher.Add(1)
+}
+
+func TestBatcherReportsPanicsInFlush(t *testing.T) {
+	explode := func([]int) { panic("flush failed") }
+	batcher := NewBatcher(2, time.Hour, explode)
+	var panicErr *PanicError
+	if err := batcher.Add(1, 2); !errors.As(err, &panicErr) || panicErr.Value != "flush failed" {
+		t.Errorf("Add() = %v, expected a *PanicError from flush", err)
+	}
+	if err := batcher.Add(3); err != nil {
+		t.Errorf("Add() without a full batch = %v, expected nil", err)
+	}
+	if err := batcher.Close(); !errors.As(err, &panicErr) {
+		t.Errorf("Close() = %v, expected a *PanicError from flush", err)
+	}
+}
+
+func TestBatcherReportsPanicsInDelayedFlush(t *testing.T) {
+	flushed := make(chan struct{})
+	batcher := NewBatcher(100, time.Millisecond, func([]int) {
+		defer close(flushed)
+		panic("flush failed")
+	})
+	batcher.Add(1)
+	<-flushed
+	var panicErr *PanicError
+	deadline := time.Now().Add(time.Second)
+	err := batcher.Flush()
+	for err == nil && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+		err = batcher.Flush()
+	}
+	if !errors.As(err, &panicErr) {
+		t.Errorf("Flush() after a delayed flush panicked = %v, expected a *PanicError", err)
+	}
+	if err := batcher.Flush(); err != nil {
+		t.Errorf("second Flush() = %v, expected the error to be reported once", err)
+	}
+}
+
+func TestBatcherAddDoesNotWaitForSlowFlush(t *testing.T) {
+	started := make(chan struct{})
+	unblock := make(chan struct{})
+	var recorded recordedBatches
+	batcher := NewBatcher(2, time.Hour, func(batch []int) {
+		if batch[0] == 1 {
+			close(started)
+			<-unblock
+		}
+		recorded.flush(batch)
+	})
+	go batcher.Add(1, 2)
+	<-started
+	added := make(chan struct{})
+	go func() {
+		var wg sync.WaitGroup
+		for _, values := range [][]int{{3, 4}, {5, 6}} {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				batcher.Add(values...)
+			}()
+		}
+		wg.Wait()
+		batcher.Add(7) // does not complete a batch
+		close(added)
+	}()
+	select {
+	case <-added:
+	case <-time.After(time.Second):
+		t.Fatalf("Add() blocked while flush was running")
+	}
+	close(unblock)
+	batcher.Close()
+
+	got := recorded.get()
+	if len(got) != 4 {
+		t.Fatalf("batches = %v, expected 4 batches", got)
+	}
+	// the two batch-completing Adds raced, so their batches may come in either order
+	slices.SortFunc(got[1:3], func(a, b []int) int { return a[0] - b[0] })
+	if expected := [][]int{{1, 2}, {3, 4}, {5, 6}, {7}}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("batches = %v, expected %v", got, expected)
+	}
+}
+
+func TestBatcherFlushMayAdd(t *testing.T) {
+	var recorded recordedBatches
+	var batcher *Batcher[int]
+	batcher = NewBatcher(2, time.Hour, func(batch []int) {
+		recorded.flush(batch)
+		if batch[0] < 10 {
+			batcher.Add(batch[0]+10, batch[1]+10)
+		}
+	})
+	batcher.Add(1, 2)
+	batcher.Close()
+	if got, expected := recorded.get(), [][]int{{1, 2}, {11, 12}}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("batches = %v, expected %v", got, expected)
+	}
+}
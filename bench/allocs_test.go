@@ -0,0 +1,58 @@
+package bench
+
+import (
+	"testing"
+
+	"github.com/Sedose/godelin"
+)
+
+// allocBudgets pins the allocation count of each call. The zero-allocation variants
+// (InPlace, To, predicates, folds) must stay at zero; the allocating ones must not regress.
+var allocBudgets = []struct {
+	name   string
+	budget float64
+	run    func(input []int, destination []int)
+}{
+	{"All", 0, func(input, _ []int) { godelin.All(input, isNonNegative) }},
+	{"Any", 0, func(input, _ []int) { godelin.Any(input, isNegative) }},
+	{"DropLastWhile", 0, func(input, _ []int) { godelin.DropLastWhile(input, isNegative) }},
+	{"DropUntil", 0, func(input, _ []int) { godelin.DropUntil(input, isNonNegative) }},
+	{"DropWhile", 0, func(input, _ []int) { godelin.DropWhile(input, isNegative) }},
+	{"FilterInPlace", 0, func(input, _ []int) { godelin.FilterInPlace(input, isNonNegative) }},
+	{"FilterTo", 0, func(input, destination []int) { godelin.FilterTo(input, destination[:0], isEven) }},
+	{"Fold", 0, func(input, _ []int) { godelin.Fold(input, 0, sum) }},
+	{"FoldIndexed", 0, func(input, _ []int) { godelin.FoldIndexed(input, 0, indexedSum) }},
+	{"FoldWhile", 0, func(input, _ []int) { godelin.FoldWhile(input, 0, sumWhile) }},
+	{"ForEach", 0, func(input, _ []int) { godelin.ForEach(input, noop) }},
+	{"ForEachIndexed", 0, func(input, _ []int) { godelin.ForEachIndexed(input, indexedNoop) }},
+	{"MapInPlace", 0, func(_, destination []int) { godelin.MapInPlace(destination, double) }},
+	{"MapTo", 0, func(input, destination []int) { godelin.MapTo(input, destination[:0], double) }},
+	{"OnEach", 0, func(input, _ []int) { godelin.OnEach(input, noop) }},
+	{"Reduce", 0, func(input, _ []int) { godelin.Reduce(input, sum) }},
+	{"ReduceWhile", 0, func(input, _ []int) { godelin.ReduceWhile(input, sumWhile) }},
+	{"TakeLastWhile", 0, func(input, _ []int) { godelin.TakeLastWhile(input, isNonNegative) }},
+	{"TakeUntil", 0, func(input, _ []int) { godelin.TakeUntil(input, isNegative) }},
+	{"TakeWhile", 0, func(input, _ []int) { godelin.TakeWhile(input, isNonNegative) }},
+	{"Filter", 1, func(input, _ []int) { godelin.Filter(input, isEven) }},
+	{"Map", 1, func(input, _ []int) { godelin.Map(input, double) }},
+	{"MapIndexed", 1, func(input, _ []int) { godelin.MapIndexed(input, indexedDouble) }},
+	{"MovingSum", 1, func(input, _ []int) { godelin.MovingSum(input, 5) }},
+	{"Plus", 1, func(input, _ []int) { godelin.Plus(input, 1) }},
+	{"Partition", 2, func(input, _ []int) { godelin.Partition(input, isEven) }},
+	{"GroupBy", 25, func(input, _ []int) { godelin.GroupBy(input, parity) }},
+	{"GroupByTwoPass", 5, func(input, _ []int) { godelin.GroupByTwoPass(input, parity) }},
+	{"MovingMax", 2, func(input, _ []int) { godelin.MovingMax(input, 5) }},
+}
+
+func TestAllocationBudgets(t *testing.T) {
+	input := ints(1_000)
+	destination := make([]int, len(input))
+	for _, testCase := range allocBudgets {
+		t.Run(testCase.name, func(t *testing.T) {
+			allocs := testing.AllocsPerRun(100, func() { testCase.run(input, destination) })
+			if allocs > testCase.budget {
+				t.Errorf("%s allocated %v times per run, budget is %v", testCase.name, allocs, testCase.budget)
+			}
+		})
+	}
+}
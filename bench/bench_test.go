@@ -0,0 +1,832 @@
+package bench
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/Sedose/godelin"
+)
+
+var sizes = []struct {
+	name string
+	n    int
+}{
+	{"small", 10},
+	{"medium", 1_000},
+	{"large", 100_000},
+}
+
+func ints(n int) []int {
+	result := make([]int, n)
+	for i := range result {
+		result[i] = i % (n/2 + 1)
+	}
+	return result
+}
+
+func intMap(n int) map[int]int {
+	result := make(map[int]int, n)
+	for i := 0; i < n; i++ {
+		result[i] = i
+	}
+	return result
+}
+
+func stringMap(n int) map[string]string {
+	result := make(map[string]string, n)
+	for i := 0; i < n; i++ {
+		result["ns"+strconv.Itoa(i%7)+"."+strconv.Itoa(i)] = strconv.Itoa(i)
+	}
+	return result
+}
+
+func benchSlice(b *testing.B, run func(b *testing.B, input []int)) {
+	for _, size := range sizes {
+		input := ints(size.n)
+		b.Run(size.name, func(b *testing.B) {
+			b.ReportAllocs()
+			run(b, input)
+		})
+	}
+}
+
+func benchMap(b *testing.B, run func(b *testing.B, input map[int]int)) {
+	for _, size := range sizes {
+		input := intMap(size.n)
+		b.Run(size.name, func(b *testing.B) {
+			b.ReportAllocs()
+			run(b, input)
+		})
+	}
+}
+
+func benchStringMap(b *testing.B, run func(b *testing.B, input map[string]string)) {
+	for _, size := range sizes {
+		input := stringMap(size.n)
+		b.Run(size.name, func(b *testing.B) {
+			b.ReportAllocs()
+			run(b, input)
+		})
+	}
+}
+
+func isEven(x int) bool                      { return x%2 == 0 }
+func isNonNegative(x int) bool               { return x >= 0 }
+func isNegative(x int) bool                  { return x < 0 }
+func double(x int) int                       { return x * 2 }
+func sum(acc, x int) int                     { return acc + x }
+func sumWhile(acc, x int) (int, bool)        { return acc + x, true }
+func parity(x int) (bool, int)               { return x%2 == 0, x }
+func identity(x int) (int, int)              { return x, x }
+func bucket(x int) (int, int)                { return x / 100, x }
+func indexedEven(i, _ int) bool              { return i%2 == 0 }
+func indexedDouble(i, x int) int             { return i + x }
+func indexedSum(i, acc, x int) int           { return acc + x + i }
+func pairOf(x int) []int                     { return []int{x, x} }
+func indexedPairOf(i, x int) []int           { return []int{i, x} }
+func ascending(prev, next int) bool          { return prev < next }
+func noop(int)                               {}
+func indexedNoop(int, int)                   {}
+func swapEntry(k, v int) (int, int)          { return v, k }
+func sumEntries(acc, k, v int) int           { return acc + k + v }
+func encodeInts(chunk []int) ([]byte, error) { return []byte(fmt.Sprint(chunk)), nil }
+
+func BenchmarkAll(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.All(input, isNonNegative)
+		}
+	})
+}
+
+func BenchmarkAny(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.Any(input, isNegative)
+		}
+	})
+}
+
+func BenchmarkAssociate(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.Associate(input, identity)
+		}
+	})
+}
+
+func BenchmarkAssociateTo(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		destination := make(map[int]int, len(input))
+		for b.Loop() {
+			godelin.AssociateTo(input, destination, identity)
+		}
+	})
+}
+
+func BenchmarkChunkedBy(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.ChunkedBy(input, ascending)
+		}
+	})
+}
+
+func BenchmarkChunkedByView(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.ChunkedByView(input, ascending)
+		}
+	})
+}
+
+func BenchmarkDistinct(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.Distinct(input)
+		}
+	})
+}
+
+func BenchmarkDistinctBy(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.DistinctBy(input, double)
+		}
+	})
+}
+
+func BenchmarkDistinctInPlace(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		scratch := make([]int, len(input))
+		for b.Loop() {
+			copy(scratch, input) // DistinctInPlace destroys its input
+			godelin.DistinctInPlace(scratch)
+		}
+	})
+}
+
+func BenchmarkDropLastWhile(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.DropLastWhile(input, isNonNegative)
+		}
+	})
+}
+
+func BenchmarkDropUntil(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.DropUntil(input, isNegative)
+		}
+	})
+}
+
+func BenchmarkDropWhile(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.DropWhile(input, isNonNegative)
+		}
+	})
+}
+
+func BenchmarkEncodeChunks(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			_, _ = godelin.EncodeChunks(input, 64, encodeInts)
+		}
+	})
+}
+
+func BenchmarkDecodeChunks(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		chunks, _ := godelin.EncodeChunks(input, 64, encodeInts)
+		decode := func(data []byte) ([]int, error) { return []int{len(data)}, nil }
+		for b.Loop() {
+			_, _ = godelin.DecodeChunks(chunks, decode)
+		}
+	})
+}
+
+func BenchmarkFilter(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.Filter(input, isEven)
+		}
+	})
+}
+
+func BenchmarkFilterIndexed(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.FilterIndexed(input, indexedEven)
+		}
+	})
+}
+
+func BenchmarkFilterInPlace(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.FilterInPlace(input, isNonNegative)
+		}
+	})
+}
+
+func BenchmarkFilterTo(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		destination := make([]int, 0, len(input))
+		for b.Loop() {
+			godelin.FilterTo(input, destination[:0], isEven)
+		}
+	})
+}
+
+func BenchmarkFindWithContext(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		target := input[len(input)-1]
+		for b.Loop() {
+			godelin.FindWithContext(input, func(x int) bool { return x == target }, 3, 3)
+		}
+	})
+}
+
+func BenchmarkFlatMap(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.FlatMap(input, pairOf)
+		}
+	})
+}
+
+func BenchmarkFlatMapIndexed(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.FlatMapIndexed(input, indexedPairOf)
+		}
+	})
+}
+
+func BenchmarkFold(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.Fold(input, 0, sum)
+		}
+	})
+}
+
+func BenchmarkFoldIndexed(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.FoldIndexed(input, 0, indexedSum)
+		}
+	})
+}
+
+func BenchmarkFoldWhile(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.FoldWhile(input, 0, sumWhile)
+		}
+	})
+}
+
+func BenchmarkForEach(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.ForEach(input, noop)
+		}
+	})
+}
+
+func BenchmarkForEachIndexed(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.ForEachIndexed(input, indexedNoop)
+		}
+	})
+}
+
+func BenchmarkGroupBy(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.GroupBy(input, parity)
+		}
+	})
+}
+
+func BenchmarkGroupByTo(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.GroupByTo(input, make(map[bool][]int, 2), parity)
+		}
+	})
+}
+
+func BenchmarkGroupByTwoPass(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.GroupByTwoPass(input, parity)
+		}
+	})
+}
+
+// The Buckets benchmarks put up to 100 values under each key, where GroupBy's append growth
+// is most visible next to GroupByTwoPass's exact-size groups.
+func BenchmarkGroupByBuckets(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.GroupBy(input, bucket)
+		}
+	})
+}
+
+func BenchmarkGroupByTwoPassBuckets(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.GroupByTwoPass(input, bucket)
+		}
+	})
+}
+
+func BenchmarkGroupByToBuckets(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.GroupByTo(input, make(map[int][]int), bucket)
+		}
+	})
+}
+
+func BenchmarkIntersect(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		other := input[len(input)/2:]
+		for b.Loop() {
+			godelin.Intersect(input, other)
+		}
+	})
+}
+
+func BenchmarkMap(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.Map(input, double)
+		}
+	})
+}
+
+func BenchmarkMapIndexed(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.MapIndexed(input, indexedDouble)
+		}
+	})
+}
+
+func BenchmarkMapInPlace(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		scratch := make([]int, len(input))
+		for b.Loop() {
+			godelin.MapInPlace(scratch, double)
+		}
+	})
+}
+
+func BenchmarkMapTo(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		destination := make([]int, 0, len(input))
+		for b.Loop() {
+			godelin.MapTo(input, destination[:0], double)
+		}
+	})
+}
+
+func BenchmarkMinus(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		other := input[:len(input)/4]
+		for b.Loop() {
+			godelin.Minus(input, other)
+		}
+	})
+}
+
+func BenchmarkMinusElement(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.MinusElement(input, input[len(input)/2])
+		}
+	})
+}
+
+func BenchmarkMovingSum(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.MovingSum(input, 5)
+		}
+	})
+}
+
+func BenchmarkMovingAverage(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.MovingAverage(input, 5)
+		}
+	})
+}
+
+func BenchmarkMovingMin(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.MovingMin(input, 5)
+		}
+	})
+}
+
+func BenchmarkMovingMax(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.MovingMax(input, 5)
+		}
+	})
+}
+
+func BenchmarkOnEach(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.OnEach(input, noop)
+		}
+	})
+}
+
+func BenchmarkPartition(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.Partition(input, isEven)
+		}
+	})
+}
+
+func BenchmarkPlus(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.Plus(input, 1, 2, 3)
+		}
+	})
+}
+
+func BenchmarkReduce(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.Reduce(input, sum)
+		}
+	})
+}
+
+func BenchmarkReduceIndexed(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.ReduceIndexed(input, indexedSum)
+		}
+	})
+}
+
+func BenchmarkReduceWhile(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.ReduceWhile(input, sumWhile)
+		}
+	})
+}
+
+func BenchmarkTakeLastWhile(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.TakeLastWhile(input, isNonNegative)
+		}
+	})
+}
+
+func BenchmarkTakeUntil(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.TakeUntil(input, isNegative)
+		}
+	})
+}
+
+func BenchmarkTakeWhile(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.TakeWhile(input, isNonNegative)
+		}
+	})
+}
+
+func BenchmarkUnion(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		other := input[len(input)/2:]
+		for b.Loop() {
+			godelin.Union(input, other)
+		}
+	})
+}
+
+func BenchmarkWindowed(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.Windowed(input, 5, 5)
+		}
+	})
+}
+
+func BenchmarkWindowedView(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.WindowedView(input, 5, 5)
+		}
+	})
+}
+
+func BenchmarkZip(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.Zip(input, input)
+		}
+	})
+}
+
+func BenchmarkUnzip(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		pairs := godelin.Zip(input, input)
+		for b.Loop() {
+			godelin.Unzip(pairs)
+		}
+	})
+}
+
+func BenchmarkFoldMapEntries(b *testing.B) {
+	benchMap(b, func(b *testing.B, input map[int]int) {
+		for b.Loop() {
+			godelin.FoldMapEntries(input, 0, sumEntries)
+		}
+	})
+}
+
+func BenchmarkGetOrPut(b *testing.B) {
+	benchMap(b, func(b *testing.B, input map[int]int) {
+		for b.Loop() {
+			godelin.GetOrPut(input, 1, double)
+		}
+	})
+}
+
+func BenchmarkItems(b *testing.B) {
+	benchMap(b, func(b *testing.B, input map[int]int) {
+		for b.Loop() {
+			godelin.Items(input)
+		}
+	})
+}
+
+func BenchmarkMapEntries(b *testing.B) {
+	benchMap(b, func(b *testing.B, input map[int]int) {
+		for b.Loop() {
+			godelin.MapEntries(input, swapEntry)
+		}
+	})
+}
+
+func BenchmarkMergeValues(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		grouped := godelin.GroupBy(input, parity)
+		for b.Loop() {
+			godelin.MergeValues(grouped, grouped)
+		}
+	})
+}
+
+func BenchmarkFirstValue(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		grouped := godelin.GroupBy(input, parity)
+		for b.Loop() {
+			godelin.FirstValue(grouped, true)
+		}
+	})
+}
+
+func BenchmarkPrefixKeys(b *testing.B) {
+	benchStringMap(b, func(b *testing.B, input map[string]string) {
+		for b.Loop() {
+			godelin.PrefixKeys(input, "app.")
+		}
+	})
+}
+
+func BenchmarkFilterKeysByPrefix(b *testing.B) {
+	benchStringMap(b, func(b *testing.B, input map[string]string) {
+		for b.Loop() {
+			godelin.FilterKeysByPrefix(input, "ns1.")
+		}
+	})
+}
+
+func BenchmarkStripKeyPrefix(b *testing.B) {
+	benchStringMap(b, func(b *testing.B, input map[string]string) {
+		for b.Loop() {
+			godelin.StripKeyPrefix(input, "ns1.")
+		}
+	})
+}
+
+func BenchmarkPartitionKeysByPrefix(b *testing.B) {
+	benchStringMap(b, func(b *testing.B, input map[string]string) {
+		for b.Loop() {
+			godelin.PartitionKeysByPrefix(input, ".")
+		}
+	})
+}
+
+func BenchmarkFromEnviron(b *testing.B) {
+	for _, size := range sizes {
+		environ := make([]string, size.n)
+		for i := range environ {
+			environ[i] = "VAR_" + strconv.Itoa(i) + "=" + strconv.Itoa(i)
+		}
+		b.Run(size.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				godelin.FromEnviron(environ)
+			}
+		})
+	}
+}
+
+func BenchmarkFilterEnvPrefix(b *testing.B) {
+	benchStringMap(b, func(b *testing.B, input map[string]string) {
+		for b.Loop() {
+			godelin.FilterEnvPrefix(input, "ns1.")
+		}
+	})
+}
+
+var env = map[string]string{"PORT": "8080", "DEBUG": "true", "TIMEOUT": "1m30s"}
+
+func BenchmarkEnvInt(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		_, _ = godelin.EnvInt(env, "PORT", 0)
+	}
+}
+
+func BenchmarkEnvBool(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		_, _ = godelin.EnvBool(env, "DEBUG", false)
+	}
+}
+
+func BenchmarkEnvDuration(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		_, _ = godelin.EnvDuration(env, "TIMEOUT", 0)
+	}
+}
+
+// benchErrors runs each size with every third error set, the rest nil.
+func benchErrors(b *testing.B, run func(b *testing.B, errs []error)) {
+	for _, size := range sizes {
+		errs := make([]error, size.n)
+		for i := range errs {
+			if i%3 == 0 {
+				errs[i] = errors.New("failed")
+			}
+		}
+		b.Run(size.name, func(b *testing.B) {
+			b.ReportAllocs()
+			run(b, errs)
+		})
+	}
+}
+
+func BenchmarkAnyError(b *testing.B) {
+	benchErrors(b, func(b *testing.B, errs []error) {
+		for b.Loop() {
+			godelin.AnyError(errs)
+		}
+	})
+}
+
+func BenchmarkCompactErrors(b *testing.B) {
+	benchErrors(b, func(b *testing.B, errs []error) {
+		for b.Loop() {
+			godelin.CompactErrors(errs)
+		}
+	})
+}
+
+func BenchmarkJoinWithIndices(b *testing.B) {
+	benchErrors(b, func(b *testing.B, errs []error) {
+		for b.Loop() {
+			_ = godelin.JoinWithIndices(errs)
+		}
+	})
+}
+
+func BenchmarkChunkContentDefined(b *testing.B) {
+	for _, size := range sizes {
+		data := bytes.Repeat([]byte("godelin content defined chunking "), size.n)
+		b.Run(size.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(data)))
+			for b.Loop() {
+				for _, err := range godelin.ChunkContentDefined(bytes.NewReader(data), 4096) {
+					if err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkLet(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		godelin.Let(21, double)
+	}
+}
+
+func BenchmarkTakeIf(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		godelin.TakeIf(2, isEven)
+	}
+}
+
+func BenchmarkTakeUnless(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		godelin.TakeUnless(3, isEven)
+	}
+}
+
+func BenchmarkSafeGo(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		<-godelin.SafeGo(func() {})
+	}
+}
+
+// The MultiMap benchmarks key each element by its value, so most keys hold one or two values.
+func BenchmarkMultiMapAdd(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			m := godelin.NewMultiMap[int, int]()
+			for _, element := range input {
+				m.Add(element, element)
+			}
+		}
+	})
+}
+
+func BenchmarkGroupByMultiMap(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		for b.Loop() {
+			godelin.GroupByMultiMap(input, identity)
+		}
+	})
+}
+
+func BenchmarkMultiMapGet(b *testing.B) {
+	benchSlice(b, func(b *testing.B, input []int) {
+		m := godelin.GroupByMultiMap(input, identity)
+		for b.Loop() {
+			for _, element := range input {
+				m.Get(element)
+			}
+		}
+	})
+}
+
+func squareMatrix(n int) [][]int {
+	matrix := make([][]int, n)
+	for i := range matrix {
+		matrix[i] = ints(n)
+	}
+	return matrix
+}
+
+func BenchmarkTranspose(b *testing.B) {
+	matrix := squareMatrix(2048)
+	b.Run("naive", func(b *testing.B) {
+		for b.Loop() {
+			godelin.Transpose(matrix)
+		}
+	})
+	b.Run("blocked", func(b *testing.B) {
+		for b.Loop() {
+			godelin.TransposeBlocked(matrix, godelin.DefaultBlockSize)
+		}
+	})
+}
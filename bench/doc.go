@@ -0,0 +1,3 @@
+// Package bench holds the benchmarks and allocation budgets for godelin.
+// Run with: go test -bench=. -benchmem ./bench
+package bench
@@ -0,0 +1,102 @@
+package godelin
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrBiMapConflict = errors.New("value already bound to another key")
+
+type BiMap[K, V comparable] struct {
+	forward map[K]V
+	inverse map[V]K
+}
+
+func NewBiMap[K, V comparable]() *BiMap[K, V] {
+	return &BiMap[K, V]{forward: make(map[K]V), inverse: make(map[V]K)}
+}
+
+func BiMapOf[M ~map[K]V, K, V comparable](m M) (*BiMap[K, V], error) {
+	result := &BiMap[K, V]{forward: make(map[K]V, len(m)), inverse: make(map[V]K, len(m))}
+	for key, value := range m {
+		if err := result.Put(key, value); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (b *BiMap[K, V]) Len() int {
+	return len(b.forward)
+}
+
+func (b *BiMap[K, V]) Get(key K) (V, bool) {
+	value, exists := b.forward[key]
+	return value, exists
+}
+
+func (b *BiMap[K, V]) GetByValue(value V) (K, bool) {
+	key, exists := b.inverse[value]
+	return key, exists
+}
+
+// Put binds key to value, replacing the key's previous value. It fails without
+// modifying the map when value is already bound to a different key.
+func (b *BiMap[K, V]) Put(key K, value V) error {
+	if owner, exists := b.inverse[value]; exists && owner != key {
+		return fmt.Errorf("put %v=%v: %w %v", key, value, ErrBiMapConflict, owner)
+	}
+	b.RemoveKey(key)
+	b.forward[key] = value
+	b.inverse[value] = key
+	return nil
+}
+
+// ForcePut binds key to value, removing whatever entries either of them was bound to.
+func (b *BiMap[K, V]) ForcePut(key K, value V) {
+	b.RemoveValue(value)
+	b.RemoveKey(key)
+	b.forward[key] = value
+	b.inverse[value] = key
+}
+
+func (b *BiMap[K, V]) PutIfAbsent(key K, value V) bool {
+	if _, exists := b.forward[key]; exists {
+		return false
+	}
+	if _, exists := b.inverse[value]; exists {
+		return false
+	}
+	b.forward[key] = value
+	b.inverse[value] = key
+	return true
+}
+
+func (b *BiMap[K, V]) RemoveKey(key K) bool {
+	value, exists := b.forward[key]
+	if !exists {
+		return false
+	}
+	delete(b.forward, key)
+	delete(b.inverse, value)
+	return true
+}
+
+func (b *BiMap[K, V]) RemoveValue(value V) bool {
+	key, exists := b.inverse[value]
+	if !exists {
+		return false
+	}
+	delete(b.inverse, value)
+	delete(b.forward, key)
+	return true
+}
+
+// Inverse returns a view with keys and values swapped; changes through either side are visible in both.
+func (b *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	return &BiMap[V, K]{forward: b.inverse, inverse: b.forward}
+}
+
+func (b *BiMap[K, V]) Items() []Pair[K, V] {
+	return Items(b.forward)
+}
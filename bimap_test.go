@@ -0,0 +1,115 @@
+package godelin
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestBiMapLookupsInBothDirections(t *testing.T) {
+	b := NewBiMap[int, string]()
+	if err := b.Put(1, "alice"); err != nil {
+		t.Fatalf("Put() unexpected error: %v", err)
+	}
+	if err := b.Put(2, "bob"); err != nil {
+		t.Fatalf("Put() unexpected error: %v", err)
+	}
+	if name, found := b.Get(1); name != "alice" || !found {
+		t.Errorf("Get(1) = %q, %v, expected alice, true", name, found)
+	}
+	if id, found := b.GetByValue("bob"); id != 2 || !found {
+		t.Errorf("GetByValue(bob) = %v, %v, expected 2, true", id, found)
+	}
+	if _, found := b.GetByValue("carol"); found {
+		t.Errorf("GetByValue(carol) found a key")
+	}
+}
+
+func TestBiMapPutReplacesValueOfSameKey(t *testing.T) {
+	b := NewBiMap[int, string]()
+	_ = b.Put(1, "alice")
+	if err := b.Put(1, "alicia"); err != nil {
+		t.Fatalf("Put() unexpected error: %v", err)
+	}
+	if _, found := b.GetByValue("alice"); found {
+		t.Errorf("old value is still in the inverse index")
+	}
+	if id, _ := b.GetByValue("alicia"); id != 1 || b.Len() != 1 {
+		t.Errorf("GetByValue(alicia) = %v with Len %d, expected 1 with Len 1", id, b.Len())
+	}
+}
+
+func TestBiMapPutDetectsConflicts(t *testing.T) {
+	b := NewBiMap[int, string]()
+	_ = b.Put(1, "alice")
+	err := b.Put(2, "alice")
+	if !errors.Is(err, ErrBiMapConflict) || err.Error() != "put 2=alice: value already bound to another key 1" {
+		t.Errorf("Put() error = %v, expected a conflict", err)
+	}
+	if _, found := b.Get(2); found || b.Len() != 1 {
+		t.Errorf("conflicting Put() modified the map")
+	}
+}
+
+func TestBiMapForcePut(t *testing.T) {
+	b := NewBiMap[int, string]()
+	_ = b.Put(1, "alice")
+	_ = b.Put(2, "bob")
+	b.ForcePut(2, "alice")
+	expected := []Pair[int, string]{{2, "alice"}}
+	if items := b.Items(); !reflect.DeepEqual(items, expected) {
+		t.Errorf("Items() after ForcePut = %v, expected %v", items, expected)
+	}
+}
+
+func TestBiMapPutIfAbsent(t *testing.T) {
+	b := NewBiMap[int, string]()
+	if !b.PutIfAbsent(1, "alice") {
+		t.Errorf("PutIfAbsent() into an empty map = false")
+	}
+	if b.PutIfAbsent(1, "bob") || b.PutIfAbsent(2, "alice") {
+		t.Errorf("PutIfAbsent() overwrote an existing key or value")
+	}
+	if b.Len() != 1 {
+		t.Errorf("Len() = %d, expected 1", b.Len())
+	}
+}
+
+func TestBiMapRemove(t *testing.T) {
+	b := NewBiMap[int, string]()
+	_ = b.Put(1, "alice")
+	_ = b.Put(2, "bob")
+	if !b.RemoveKey(1) || b.RemoveKey(1) {
+		t.Errorf("RemoveKey() returned unexpected results")
+	}
+	if _, found := b.GetByValue("alice"); found {
+		t.Errorf("RemoveKey() left the value in the inverse index")
+	}
+	if !b.RemoveValue("bob") || b.Len() != 0 {
+		t.Errorf("RemoveValue() did not remove the entry")
+	}
+}
+
+func TestBiMapInverse(t *testing.T) {
+	b := NewBiMap[int, string]()
+	_ = b.Put(1, "alice")
+	inverse := b.Inverse()
+	_ = inverse.Put("bob", 2)
+	if name, found := b.Get(2); name != "bob" || !found {
+		t.Errorf("change through Inverse() is not visible: Get(2) = %q, %v", name, found)
+	}
+}
+
+func TestBiMapOf(t *testing.T) {
+	names := Associate([]string{"alice", "bob"}, func(name string) (string, int) { return name, len(name) })
+	b, err := BiMapOf(names)
+	if err != nil {
+		t.Fatalf("BiMapOf() unexpected error: %v", err)
+	}
+	if name, _ := b.GetByValue(3); name != "bob" {
+		t.Errorf("GetByValue(3) = %q, expected bob", name)
+	}
+	if _, err := BiMapOf(map[string]int{"ann": 3, "bob": 3}); !errors.Is(err, ErrBiMapConflict) {
+		t.Errorf("BiMapOf() with duplicate values error = %v, expected a conflict", err)
+	}
+}
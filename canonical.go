@@ -0,0 +1,137 @@
+package godelin
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+type CanonicalOptions struct {
+	// SortSlices orders the elements of every slice (except byte slices), for slices that hold
+	// sets whose order came from map iteration, such as GroupBy results built from MapEntries.
+	SortSlices bool
+}
+
+// Canonicalize returns a deep copy of value in which maps, slices, arrays, pointers, interfaces
+// and exported struct fields are copied recursively. Maps keep their type because fmt and
+// encoding/json already print them in key order; with options.SortSlices the copy is also
+// independent of element order, so equal sets compare equal with reflect.DeepEqual and produce
+// identical golden files. value must not contain cycles.
+func Canonicalize[T any](value T, options CanonicalOptions) T {
+	var result T
+	reflect.ValueOf(&result).Elem().Set(canonicalValue(reflect.ValueOf(&value).Elem(), options))
+	return result
+}
+
+func canonicalValue(value reflect.Value, options CanonicalOptions) reflect.Value {
+	switch value.Kind() {
+	case reflect.Map:
+		if value.IsNil() {
+			return value
+		}
+		result := reflect.MakeMapWithSize(value.Type(), value.Len())
+		for entry := value.MapRange(); entry.Next(); {
+			result.SetMapIndex(canonicalValue(entry.Key(), options), canonicalValue(entry.Value(), options))
+		}
+		return result
+	case reflect.Slice:
+		if value.IsNil() {
+			return value
+		}
+		result := reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+		for i := range value.Len() {
+			result.Index(i).Set(canonicalValue(value.Index(i), options))
+		}
+		if options.SortSlices && value.Type().Elem().Kind() != reflect.Uint8 {
+			sortCanonical(result)
+		}
+		return result
+	case reflect.Array:
+		result := reflect.New(value.Type()).Elem()
+		for i := range value.Len() {
+			result.Index(i).Set(canonicalValue(value.Index(i), options))
+		}
+		return result
+	case reflect.Pointer:
+		if value.IsNil() {
+			return value
+		}
+		result := reflect.New(value.Type().Elem())
+		result.Elem().Set(canonicalValue(value.Elem(), options))
+		return result
+	case reflect.Interface:
+		if value.IsNil() {
+			return value
+		}
+		result := reflect.New(value.Type()).Elem()
+		result.Set(canonicalValue(value.Elem(), options))
+		return result
+	case reflect.Struct:
+		result := reflect.New(value.Type()).Elem()
+		result.Set(value)
+		for i := range value.NumField() {
+			if field := result.Field(i); field.CanSet() {
+				field.Set(canonicalValue(value.Field(i), options))
+			}
+		}
+		return result
+	default:
+		return value
+	}
+}
+
+func sortCanonical(slice reflect.Value) {
+	elements := make([]reflect.Value, slice.Len())
+	for i := range elements {
+		elements[i] = reflect.New(slice.Type().Elem()).Elem()
+		elements[i].Set(slice.Index(i))
+	}
+	slices.SortStableFunc(elements, func(a, b reflect.Value) int {
+		return compareCanonical(unwrapInterface(a), unwrapInterface(b))
+	})
+	for i, element := range elements {
+		slice.Index(i).Set(element)
+	}
+}
+
+func unwrapInterface(value reflect.Value) reflect.Value {
+	for value.Kind() == reflect.Interface && !value.IsNil() {
+		value = value.Elem()
+	}
+	return value
+}
+
+// compareCanonical orders numbers, strings and booleans by value when both sides have the
+// same kind, and everything else by its %v formatting, which prints maps in key order.
+func compareCanonical(a, b reflect.Value) int {
+	if isNilInterface(a) || isNilInterface(b) {
+		return cmp.Compare(boolRank(!isNilInterface(a)), boolRank(!isNilInterface(b)))
+	}
+	if a.Kind() == b.Kind() {
+		switch a.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return cmp.Compare(a.Int(), b.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return cmp.Compare(a.Uint(), b.Uint())
+		case reflect.Float32, reflect.Float64:
+			return cmp.Compare(a.Float(), b.Float())
+		case reflect.String:
+			return cmp.Compare(a.String(), b.String())
+		case reflect.Bool:
+			return cmp.Compare(boolRank(a.Bool()), boolRank(b.Bool()))
+		}
+	}
+	return cmp.Compare(fmt.Sprintf("%T %v", a.Interface(), a.Interface()), fmt.Sprintf("%T %v", b.Interface(), b.Interface()))
+}
+
+func boolRank(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func isNilInterface(value reflect.Value) bool {
+	return value.Kind() == reflect.Interface && value.IsNil()
+}
@@ -0,0 +1,80 @@
+package godelin
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCanonicalizeSortsGroupedValues(t *testing.T) {
+	byLength := map[int][]string{3: {"two", "one"}, 5: {"three"}}
+	reordered := map[int][]string{3: {"one", "two"}, 5: {"three"}}
+	options := CanonicalOptions{SortSlices: true}
+	if a, b := Canonicalize(byLength, options), Canonicalize(reordered, options); !reflect.DeepEqual(a, b) {
+		t.Errorf("Canonicalize() = %v and %v, expected equal results", a, b)
+	}
+	if byLength[3][0] != "two" {
+		t.Errorf("Canonicalize() modified its input: %v", byLength)
+	}
+	if result := Canonicalize(byLength, CanonicalOptions{}); !reflect.DeepEqual(result, byLength) {
+		t.Errorf("Canonicalize() without SortSlices = %v, expected %v", result, byLength)
+	}
+}
+
+func TestCanonicalizeNilInterfaces(t *testing.T) {
+	options := CanonicalOptions{SortSlices: true}
+	if result := Canonicalize[any](nil, options); result != nil {
+		t.Errorf("Canonicalize[any](nil) = %v, expected nil", result)
+	}
+	if result := Canonicalize[error](nil, options); result != nil {
+		t.Errorf("Canonicalize[error](nil) = %v, expected nil", result)
+	}
+	values := []any{nil, 2, 1}
+	if result := Canonicalize[any](values, options); !reflect.DeepEqual(result, []any{nil, 1, 2}) {
+		t.Errorf("Canonicalize[any]() = %v, expected %v", result, []any{nil, 1, 2})
+	}
+}
+
+func TestCanonicalizeDocuments(t *testing.T) {
+	document := map[string]any{
+		"ports":  []any{443, 80, 8080, nil, "x"},
+		"nested": map[string]any{"tags": []any{"b", "a"}, "weights": []float64{2.5, -1, 10}},
+		"raw":    []byte{3, 1, 2},
+	}
+	expected := map[string]any{
+		"ports":  []any{nil, 80, 443, 8080, "x"},
+		"nested": map[string]any{"tags": []any{"a", "b"}, "weights": []float64{-1, 2.5, 10}},
+		"raw":    []byte{3, 1, 2},
+	}
+	result := Canonicalize(document, CanonicalOptions{SortSlices: true})
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Canonicalize() = %v, expected %v", result, expected)
+	}
+	result["nested"].(map[string]any)["tags"].([]any)[0] = "changed"
+	if document["nested"].(map[string]any)["tags"].([]any)[0] != "b" {
+		t.Errorf("Canonicalize() shares nested slices with its input")
+	}
+}
+
+func TestCanonicalizeStructsAndPointers(t *testing.T) {
+	type team struct {
+		Members []string
+		Lead    *string
+		secret  []int
+	}
+	lead := "ana"
+	input := []team{{Members: []string{"zoe", "ana"}, Lead: &lead, secret: []int{2, 1}}, {Members: []string{"bo"}}}
+	result := Canonicalize(input, CanonicalOptions{SortSlices: true})
+
+	if !reflect.DeepEqual(result[1].Members, []string{"bo"}) || !reflect.DeepEqual(result[0].Members, []string{"ana", "zoe"}) {
+		t.Errorf("Canonicalize() = %+v, expected sorted members", result)
+	}
+	if result[0].Lead == &lead || *result[0].Lead != lead {
+		t.Errorf("Canonicalize() did not copy the pointer target")
+	}
+	if !reflect.DeepEqual(result[0].secret, []int{2, 1}) {
+		t.Errorf("Canonicalize() secret = %v, expected unexported fields copied as is", result[0].secret)
+	}
+	if result := Canonicalize([]int(nil), CanonicalOptions{SortSlices: true}); result != nil {
+		t.Errorf("Canonicalize(nil) = %v, expected nil", result)
+	}
+}
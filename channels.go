@@ -0,0 +1,140 @@
+package godelin
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// SliceToChan sends the elements on an unbuffered channel that is closed after the last
+// element or when ctx is done, whichever comes first.
+func SliceToChan[T any](ctx context.Context, slice []T) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for _, element := range slice {
+			select {
+			case out <- element:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// ChanToSlice collects elements until ch is closed. If ctx is done first it returns what was
+// received so far along with ctx.Err().
+func ChanToSlice[T any](ctx context.Context, ch <-chan T) ([]T, error) {
+	result := make([]T, 0)
+	for {
+		select {
+		case element, ok := <-ch:
+			if !ok {
+				return result, nil
+			}
+			result = append(result, element)
+		case <-ctx.Done():
+			return result, ctx.Err()
+		}
+	}
+}
+
+// BatchChan groups elements into batches of up to size, emitting a partial batch once maxWait
+// has passed since its first element arrived. The pending batch is flushed when ch is closed.
+func BatchChan[T any](ch <-chan T, size int, maxWait time.Duration) <-chan []T {
+	if size <= 0 || maxWait <= 0 {
+		panic("BatchChan: size and maxWait must be positive")
+	}
+	out := make(chan []T)
+	go func() {
+		defer close(out)
+		batch := make([]T, 0, size)
+		timer := time.NewTimer(maxWait)
+		timer.Stop()
+		flush := func() {
+			timer.Stop()
+			out <- batch
+			batch = make([]T, 0, size)
+		}
+		for {
+			select {
+			case element, ok := <-ch:
+				if !ok {
+					if len(batch) > 0 {
+						flush()
+					}
+					return
+				}
+				batch = append(batch, element)
+				if len(batch) == 1 {
+					timer.Reset(maxWait)
+				}
+				if len(batch) == size {
+					flush()
+				}
+			case <-timer.C:
+				if len(batch) > 0 {
+					flush()
+				}
+			}
+		}
+	}()
+	return out
+}
+
+// FanIn merges the channels into one that is closed once all of them are closed or ctx is done.
+func FanIn[T any](ctx context.Context, channels ...<-chan T) <-chan T {
+	out := make(chan T)
+	var wg sync.WaitGroup
+	for _, ch := range channels {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for element := range ch {
+				select {
+				case out <- element:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// FanOut distributes elements of ch across n channels; each element goes to whichever
+// consumer is ready first. All outputs are closed once ch is closed or ctx is done.
+func FanOut[T any](ctx context.Context, ch <-chan T, n int) []<-chan T {
+	if n <= 0 {
+		panic("FanOut: n must be positive")
+	}
+	outputs := make([]<-chan T, 0, n)
+	for range n {
+		out := make(chan T)
+		outputs = append(outputs, out)
+		go func() {
+			defer close(out)
+			for {
+				select {
+				case element, ok := <-ch:
+					if !ok {
+						return
+					}
+					select {
+					case out <- element:
+					case <-ctx.Done():
+						return
+					}
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	return outputs
+}
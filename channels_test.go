@@ -0,0 +1,111 @@
+package godelin
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSliceToChanAndBack(t *testing.T) {
+	ctx := context.Background()
+	result, err := ChanToSlice(ctx, SliceToChan(ctx, []int{1, 2, 3}))
+	if err != nil || !reflect.DeepEqual(result, []int{1, 2, 3}) {
+		t.Errorf("ChanToSlice(SliceToChan()) = %v, %v, expected [1 2 3]", result, err)
+	}
+	result, err = ChanToSlice(ctx, SliceToChan(ctx, []int{}))
+	if err != nil || !reflect.DeepEqual(result, []int{}) {
+		t.Errorf("ChanToSlice(SliceToChan([])) = %v, %v, expected []", result, err)
+	}
+}
+
+func TestSliceToChanStopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := SliceToChan(ctx, []int{1, 2, 3})
+	<-ch
+	cancel()
+	// At most one send can still win the race against cancellation.
+	remaining := 0
+	for range ch {
+		remaining++
+	}
+	if remaining > 1 {
+		t.Errorf("SliceToChan() sent %d elements after cancel, expected at most 1", remaining)
+	}
+}
+
+func TestChanToSliceCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan int, 1)
+	ch <- 1
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	result, err := ChanToSlice(ctx, ch)
+	if !errors.Is(err, context.Canceled) || !reflect.DeepEqual(result, []int{1}) {
+		t.Errorf("ChanToSlice() = %v, %v, expected [1], %v", result, err, context.Canceled)
+	}
+}
+
+func TestBatchChanBySize(t *testing.T) {
+	batches, _ := ChanToSlice(context.Background(), BatchChan(SliceToChan(context.Background(), []int{1, 2, 3, 4, 5}), 2, time.Hour))
+	if expected := [][]int{{1, 2}, {3, 4}, {5}}; !reflect.DeepEqual(batches, expected) {
+		t.Errorf("BatchChan() = %v, expected %v", batches, expected)
+	}
+}
+
+func TestBatchChanByTime(t *testing.T) {
+	in := make(chan int)
+	out := BatchChan(in, 10, 20*time.Millisecond)
+	in <- 1
+	in <- 2
+	select {
+	case batch := <-out:
+		if !reflect.DeepEqual(batch, []int{1, 2}) {
+			t.Errorf("BatchChan() = %v, expected [1 2]", batch)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("BatchChan() did not flush a partial batch after maxWait")
+	}
+	close(in)
+	if _, open := <-out; open {
+		t.Errorf("BatchChan() emitted an empty batch on close")
+	}
+}
+
+func TestFanIn(t *testing.T) {
+	ctx := context.Background()
+	merged, _ := ChanToSlice(ctx, FanIn(ctx, SliceToChan(ctx, []int{1, 2}), SliceToChan(ctx, []int{3}), SliceToChan(ctx, []int{})))
+	slices.Sort(merged)
+	if expected := []int{1, 2, 3}; !reflect.DeepEqual(merged, expected) {
+		t.Errorf("FanIn() = %v, expected %v", merged, expected)
+	}
+}
+
+func TestFanOut(t *testing.T) {
+	ctx := context.Background()
+	outputs := FanOut(ctx, SliceToChan(ctx, []int{1, 2, 3, 4, 5, 6}), 3)
+	var mu sync.Mutex
+	received := []int{}
+	var wg sync.WaitGroup
+	for _, out := range outputs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for element := range out {
+				mu.Lock()
+				received = append(received, element)
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	slices.Sort(received)
+	if expected := []int{1, 2, 3, 4, 5, 6}; !reflect.DeepEqual(received, expected) {
+		t.Errorf("FanOut() delivered %v, expected %v", received, expected)
+	}
+}
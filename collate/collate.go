@@ -0,0 +1,19 @@
+// Package collate sorts strings by language-specific collation rules. It lives apart from
+// godelin so that the root package does not depend on golang.org/x/text.
+package collate
+
+import (
+	"slices"
+
+	"github.com/Sedose/godelin"
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+// SortStrings returns a copy of slice ordered by the collation rules of the given language,
+// so accents and case sort the way readers of that language expect.
+func SortStrings(slice []string, tag language.Tag) []string {
+	sorted := slices.Clone(godelin.EmptyIfNil(slice))
+	collate.New(tag).SortStrings(sorted)
+	return sorted
+}
@@ -0,0 +1,27 @@
+package collate
+
+import (
+	"reflect"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestSortStrings(t *testing.T) {
+	input := []string{"zebra", "Äpfel", "apple", "Zoo", "Ärger"}
+	expected := []string{"Äpfel", "apple", "Ärger", "zebra", "Zoo"}
+	if result := SortStrings(input, language.German); !reflect.DeepEqual(result, expected) {
+		t.Errorf("SortStrings() = %v, expected %v", result, expected)
+	}
+	if input[0] != "zebra" {
+		t.Errorf("SortStrings() modified its input: %v", input)
+	}
+
+	swedish := SortStrings([]string{"ö", "z", "a"}, language.Swedish)
+	if expected := []string{"a", "z", "ö"}; !reflect.DeepEqual(swedish, expected) {
+		t.Errorf("SortStrings(sv) = %v, expected %v", swedish, expected)
+	}
+	if result := SortStrings(nil, language.English); !reflect.DeepEqual(result, []string{}) {
+		t.Errorf("SortStrings(nil) = %v, expected []", result)
+	}
+}
@@ -0,0 +1,129 @@
+package godelin
+
+import (
+	"iter"
+	"slices"
+)
+
+func CartesianProduct[A, B any](a []A, b []B) []Pair[A, B] {
+	result := make([]Pair[A, B], 0, len(a)*len(b))
+	for _, first := range a {
+		for _, second := range b {
+			result = append(result, Pair[A, B]{First: first, Second: second})
+		}
+	}
+	return result
+}
+
+// CartesianProductSeq lazily yields every tuple taking one element from each set, varying the
+// last set fastest. Only the current tuple is held in memory, so the product of many sets can
+// be scanned without materializing it. Each yielded slice is a fresh copy.
+func CartesianProductSeq[T any](sets ...[]T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		for _, set := range sets {
+			if len(set) == 0 {
+				return
+			}
+		}
+		indices := make([]int, len(sets))
+		for {
+			tuple := make([]T, len(sets))
+			for i, set := range sets {
+				tuple[i] = set[indices[i]]
+			}
+			if !yield(tuple) {
+				return
+			}
+			position := len(sets) - 1
+			for ; position >= 0; position-- {
+				indices[position]++
+				if indices[position] < len(sets[position]) {
+					break
+				}
+				indices[position] = 0
+			}
+			if position < 0 {
+				return
+			}
+		}
+	}
+}
+
+func Permutations[T any](slice []T) [][]T {
+	return EmptyIfNil(slices.Collect(PermutationsSeq(slice)))
+}
+
+// PermutationsSeq yields the len(slice)! orderings of slice, in lexicographic order of the
+// element positions. Each yielded slice is a fresh copy.
+func PermutationsSeq[T any](slice []T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		order := make([]int, len(slice))
+		for i := range order {
+			order[i] = i
+		}
+		for {
+			if !yield(Map(order, func(i int) T { return slice[i] })) {
+				return
+			}
+			if !nextPermutation(order) {
+				return
+			}
+		}
+	}
+}
+
+// nextPermutation rearranges order into the next lexicographic permutation and reports false
+// once order is the last one.
+func nextPermutation(order []int) bool {
+	pivot := len(order) - 2
+	for pivot >= 0 && order[pivot] >= order[pivot+1] {
+		pivot--
+	}
+	if pivot < 0 {
+		return false
+	}
+	successor := len(order) - 1
+	for order[successor] <= order[pivot] {
+		successor--
+	}
+	order[pivot], order[successor] = order[successor], order[pivot]
+	slices.Reverse(order[pivot+1:])
+	return true
+}
+
+func Combinations[T any](slice []T, k int) [][]T {
+	return EmptyIfNil(slices.Collect(CombinationsSeq(slice, k)))
+}
+
+// CombinationsSeq yields every k-element subset of slice, keeping the elements in their
+// original relative order. Each yielded slice is a fresh copy.
+func CombinationsSeq[T any](slice []T, k int) iter.Seq[[]T] {
+	if k < 0 {
+		panic("CombinationsSeq: k must not be negative")
+	}
+	return func(yield func([]T) bool) {
+		if k > len(slice) {
+			return
+		}
+		chosen := make([]int, k)
+		for i := range chosen {
+			chosen[i] = i
+		}
+		for {
+			if !yield(Map(chosen, func(i int) T { return slice[i] })) {
+				return
+			}
+			position := k - 1
+			for position >= 0 && chosen[position] == len(slice)-k+position {
+				position--
+			}
+			if position < 0 {
+				return
+			}
+			chosen[position]++
+			for i := position + 1; i < k; i++ {
+				chosen[i] = chosen[i-1] + 1
+			}
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package godelin
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestCartesianProduct(t *testing.T) {
+	got := CartesianProduct([]string{"a", "b"}, []int{1, 2})
+	expected := []Pair[string, int]{{"a", 1}, {"a", 2}, {"b", 1}, {"b", 2}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("CartesianProduct() = %v, expected %v", got, expected)
+	}
+	if got := CartesianProduct([]string{}, []int{1}); !reflect.DeepEqual(got, []Pair[string, int]{}) {
+		t.Errorf("CartesianProduct([], [1]) = %v, expected []", got)
+	}
+}
+
+func TestCartesianProductSeq(t *testing.T) {
+	testCases := []struct {
+		name     string
+		sets     [][]int
+		expected [][]int
+	}{
+		{"three sets", [][]int{{1, 2}, {3}, {4, 5}}, [][]int{{1, 3, 4}, {1, 3, 5}, {2, 3, 4}, {2, 3, 5}}},
+		{"with an empty set", [][]int{{1, 2}, {}}, nil},
+		{"no sets", nil, [][]int{{}}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := slices.Collect(CartesianProductSeq(tc.sets...)); !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("CartesianProductSeq(%v) = %v, expected %v", tc.sets, got, tc.expected)
+			}
+		})
+	}
+	count := 0
+	huge := slices.Repeat([][]int{countTo(100)}, 10)
+	for range CartesianProductSeq(huge...) {
+		count++
+		if count == 5 {
+			break
+		}
+	}
+	if count != 5 {
+		t.Errorf("expected iteration to stop after 5 tuples, got %d", count)
+	}
+}
+
+func TestPermutations(t *testing.T) {
+	testCases := []struct {
+		name     string
+		slice    []string
+		expected [][]string
+	}{
+		{"three", []string{"a", "b", "c"}, [][]string{
+			{"a", "b", "c"}, {"a", "c", "b"}, {"b", "a", "c"}, {"b", "c", "a"}, {"c", "a", "b"}, {"c", "b", "a"},
+		}},
+		{"duplicates are positional", []string{"x", "x"}, [][]string{{"x", "x"}, {"x", "x"}}},
+		{"empty", []string{}, [][]string{{}}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := Permutations(tc.slice); !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("Permutations(%v) = %v, expected %v", tc.slice, got, tc.expected)
+			}
+		})
+	}
+	if got := len(Permutations(countTo(6))); got != 720 {
+		t.Errorf("len(Permutations(6 elements)) = %d, expected 720", got)
+	}
+}
+
+func TestCombinations(t *testing.T) {
+	testCases := []struct {
+		name     string
+		k        int
+		expected [][]int
+	}{
+		{"pairs", 2, [][]int{{1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4}}},
+		{"all", 4, [][]int{{1, 2, 3, 4}}},
+		{"none", 0, [][]int{{}}},
+		{"too many", 5, [][]int{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := Combinations([]int{1, 2, 3, 4}, tc.k); !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("Combinations(%d) = %v, expected %v", tc.k, got, tc.expected)
+			}
+		})
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected a panic but did not get one")
+		}
+	}()
+	Combinations([]int{1}, -1)
+}
@@ -0,0 +1,33 @@
+package godelin
+
+// Compose returns a function that applies f and then g, so Compose(f, g)(x) is g(f(x)).
+func Compose[A, B, C any](f func(A) B, g func(B) C) func(A) C {
+	return func(value A) C { return g(f(value)) }
+}
+
+// Pipe passes value through fns from left to right.
+func Pipe[T any](value T, fns ...func(T) T) T {
+	for _, fn := range fns {
+		value = fn(value)
+	}
+	return value
+}
+
+// Partial fixes the first argument of fn.
+func Partial[A, B, R any](fn func(A, B) R, first A) func(B) R {
+	return func(second B) R { return fn(first, second) }
+}
+
+func Curry2[A, B, R any](fn func(A, B) R) func(A) func(B) R {
+	return func(a A) func(B) R {
+		return func(b B) R { return fn(a, b) }
+	}
+}
+
+func Curry3[A, B, C, R any](fn func(A, B, C) R) func(A) func(B) func(C) R {
+	return func(a A) func(B) func(C) R {
+		return func(b B) func(C) R {
+			return func(c C) R { return fn(a, b, c) }
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package godelin
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestCompose(t *testing.T) {
+	parseThenDouble := Compose(strings.TrimSpace, func(s string) int {
+		n, _ := strconv.Atoi(s)
+		return n * 2
+	})
+	if actual, expected := Map([]string{" 1", "2 ", "x"}, parseThenDouble), []int{2, 4, 0}; !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Map(Compose()) = %v, expected %v", actual, expected)
+	}
+}
+
+func TestPipe(t *testing.T) {
+	if actual := Pipe("  Hello ", strings.TrimSpace, strings.ToUpper); actual != "HELLO" {
+		t.Errorf("Pipe() = %q, expected %q", actual, "HELLO")
+	}
+	if actual := Pipe(3); actual != 3 {
+		t.Errorf("Pipe() = %v, expected 3", actual)
+	}
+}
+
+func TestPartial(t *testing.T) {
+	addPrefix := Partial(func(prefix, s string) string { return prefix + s }, "id-")
+	if actual, expected := Map([]string{"a", "b"}, addPrefix), []string{"id-a", "id-b"}; !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Map(Partial()) = %v, expected %v", actual, expected)
+	}
+}
+
+func TestCurry(t *testing.T) {
+	if actual := Curry2(strings.Repeat)("ab")(3); actual != "ababab" {
+		t.Errorf("Curry2() = %q, expected %q", actual, "ababab")
+	}
+	if actual := Curry3(strings.ReplaceAll)("a-b-c")("-")("+"); actual != "a+b+c" {
+		t.Errorf("Curry3() = %q, expected %q", actual, "a+b+c")
+	}
+}
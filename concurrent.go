@@ -0,0 +1,175 @@
+package godelin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v\n\n%s", e.Value, e.Stack)
+}
+
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+func SafeGo(fn func()) <-chan error {
+	done := make(chan error, 1)
+	go func() {
+		defer close(done)
+		done <- callSafely(func() error {
+			fn()
+			return nil
+		})
+	}()
+	return done
+}
+
+// callSafely runs fn and turns a panic into a *PanicError, so goroutines started by the
+// package report failures to the caller instead of crashing the process.
+func callSafely(fn func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = &PanicError{Value: r, Stack: debug.Stack()}
+		}
+	}()
+	return fn()
+}
+
+// ForEachConcurrent calls fn for every element using at most workers goroutines. The first
+// failure cancels the context passed to the other calls, no further elements are started, and
+// that failure is returned as "item <index>: <error>". Panics in fn count as failures.
+func ForEachConcurrent[T any](ctx context.Context, slice []T, workers int, fn func(context.Context, T) error) error {
+	return forEachConcurrent(ctx, slice, workers, fn, true)
+}
+
+// ForEachConcurrentCollect is like ForEachConcurrent but keeps going after failures and
+// returns all of them, joined as by JoinWithIndices.
+func ForEachConcurrentCollect[T any](ctx context.Context, slice []T, workers int, fn func(context.Context, T) error) error {
+	return forEachConcurrent(ctx, slice, workers, fn, false)
+}
+
+func forEachConcurrent[T any](parent context.Context, slice []T, workers int, fn func(context.Context, T) error, failFast bool) error {
+	if workers <= 0 {
+		panic("ForEachConcurrent: workers must be positive")
+	}
+	ctx, cancel := context.WithCancel(parent)
+	defer cancel()
+	errs := make([]error, len(slice))
+	var firstErr error
+	var once sync.Once
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	for range min(workers, len(slice)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ctx.Err() == nil {
+				i := int(next.Add(1) - 1)
+				if i >= len(slice) {
+					return
+				}
+				err := callSafely(func() error { return fn(ctx, slice[i]) })
+				if err != nil {
+					errs[i] = err
+					if failFast {
+						once.Do(func() { firstErr = fmt.Errorf("item %d: %w", i, err) })
+						cancel()
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if failFast {
+		if firstErr != nil {
+			return firstErr
+		}
+		return parent.Err()
+	}
+	return errors.Join(JoinWithIndices(errs), parent.Err())
+}
+
+// ProcessYielding calls fn on consecutive chunks of slice, yielding the processor with
+// runtime.Gosched between chunks so long transformations do not starve other goroutines.
+// It stops at the first error from fn, or with ctx.Err() once ctx is done.
+func ProcessYielding[T any](ctx context.Context, slice []T, chunkSize int, fn func(chunk []T) error) error {
+	if chunkSize <= 0 {
+		panic("ProcessYielding: chunkSize must be positive")
+	}
+	chunkIndex := 0
+	for chunk := range slices.Chunk(slice, chunkSize) {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := fn(chunk); err != nil {
+			return fmt.Errorf("chunk %d (elements %d-%d): %w", chunkIndex, chunkIndex*chunkSize, chunkIndex*chunkSize+len(chunk)-1, err)
+		}
+		chunkIndex++
+		runtime.Gosched()
+	}
+	return nil
+}
+
+// MapWithRetry applies fn to every element, calling it up to attempts times per element and
+// waiting backoff, then twice as long after each further failure, between calls. It returns the
+// results and a parallel slice of errors (nil where the element succeeded) for use with
+// CompactErrors or JoinWithIndices. Once ctx is done, the element being retried and all
+// remaining ones fail with ctx.Err().
+func MapWithRetry[T, R any](
+	ctx context.Context,
+	slice []T,
+	attempts int,
+	backoff time.Duration,
+	fn func(T) (R, error),
+) ([]R, []error) {
+	if attempts <= 0 {
+		panic("MapWithRetry: attempts must be positive")
+	}
+	results := make([]R, len(slice))
+	errs := make([]error, len(slice))
+	for i, element := range slice {
+		results[i], errs[i] = retry(ctx, attempts, backoff, func() (R, error) { return fn(element) })
+	}
+	return results, errs
+}
+
+func retry[R any](ctx context.Context, attempts int, backoff time.Duration, fn func() (R, error)) (R, error) {
+	var zero R
+	delay := backoff
+	for attempt := 1; ; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return zero, err
+		}
+		result, err := fn()
+		if err == nil {
+			return result, nil
+		}
+		if attempt == attempts {
+			return zero, fmt.Errorf("after %d attempts: %w", attempts, err)
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return zero, ctx.Err()
+		}
+		delay *= 2
+	}
+}
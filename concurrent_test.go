@@ -0,0 +1,241 @@
+package godelin
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSafeGo(t *testing.T) {
+	ran := false
+	if err := <-SafeGo(func() { ran = true }); err != nil || !ran {
+		t.Errorf("SafeGo() = %v, ran = %v, expected nil, true", err, ran)
+	}
+}
+
+func TestSafeGoRecoversPanic(t *testing.T) {
+	err := <-SafeGo(func() { panic("kaboom") })
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("SafeGo() = %v, expected a *PanicError", err)
+	}
+	if panicErr.Value != "kaboom" {
+		t.Errorf("PanicError.Value = %v, expected %q", panicErr.Value, "kaboom")
+	}
+	if !strings.Contains(string(panicErr.Stack), "TestSafeGoRecoversPanic") {
+		t.Errorf("PanicError.Stack does not point at the panicking function:\n%s", panicErr.Stack)
+	}
+}
+
+func TestSafeGoUnwrapsPanickedErrors(t *testing.T) {
+	cause := errors.New("invariant violated")
+	err := <-SafeGo(func() { panic(cause) })
+	if !errors.Is(err, cause) {
+		t.Errorf("SafeGo() = %v, expected it to wrap %v", err, cause)
+	}
+}
+
+func TestSafeGoClosesChannel(t *testing.T) {
+	done := SafeGo(func() {})
+	<-done
+	if _, open := <-done; open {
+		t.Errorf("SafeGo() channel was not closed after reporting")
+	}
+}
+
+// countTo returns 0, 1, ..., n-1.
+func countTo(n int) []int {
+	result := make([]int, n)
+	for i := range result {
+		result[i] = i
+	}
+	return result
+}
+
+func TestForEachConcurrent(t *testing.T) {
+	var processed, running, peak atomic.Int64
+	err := ForEachConcurrent(context.Background(), countTo(100), 4, func(ctx context.Context, n int) error {
+		current := running.Add(1)
+		for {
+			observed := peak.Load()
+			if current <= observed || peak.CompareAndSwap(observed, current) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		running.Add(-1)
+		processed.Add(int64(n))
+		return nil
+	})
+	if err != nil || processed.Load() != 4950 {
+		t.Errorf("ForEachConcurrent() = %v, processed sum %d, expected nil, 4950", err, processed.Load())
+	}
+	if peak.Load() > 4 {
+		t.Errorf("ForEachConcurrent() ran %d calls at once, expected at most 4", peak.Load())
+	}
+}
+
+func TestForEachConcurrentCancelsOnFirstError(t *testing.T) {
+	failure := errors.New("bad item")
+	var started atomic.Int64
+	err := ForEachConcurrent(context.Background(), countTo(1000), 2, func(ctx context.Context, n int) error {
+		started.Add(1)
+		if n == 3 {
+			return failure
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(time.Millisecond):
+		}
+		return nil
+	})
+	if !errors.Is(err, failure) || !strings.HasPrefix(err.Error(), "item 3: ") {
+		t.Errorf("ForEachConcurrent() = %v, expected item 3 to wrap %v", err, failure)
+	}
+	if started.Load() >= 1000 {
+		t.Errorf("ForEachConcurrent() started all %d items after a failure", started.Load())
+	}
+}
+
+func TestForEachConcurrentCollect(t *testing.T) {
+	failure := errors.New("odd")
+	var processed atomic.Int64
+	err := ForEachConcurrentCollect(context.Background(), []int{1, 2, 3, 4}, 3, func(ctx context.Context, n int) error {
+		processed.Add(1)
+		if n%2 == 1 {
+			return failure
+		}
+		return nil
+	})
+	if processed.Load() != 4 || !errors.Is(err, failure) {
+		t.Fatalf("ForEachConcurrentCollect() = %v after %d items, expected failures after 4", err, processed.Load())
+	}
+	if message := err.Error(); !strings.Contains(message, "item 0: odd") || !strings.Contains(message, "item 2: odd") {
+		t.Errorf("ForEachConcurrentCollect() = %q, expected failures for items 0 and 2", message)
+	}
+}
+
+func TestForEachConcurrentRecoversPanic(t *testing.T) {
+	err := ForEachConcurrent(context.Background(), []int{1}, 1, func(context.Context, int) error { panic("kaboom") })
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) {
+		t.Errorf("ForEachConcurrent() = %v, expected a *PanicError", err)
+	}
+}
+
+func TestForEachConcurrentParentCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	calls := 0
+	err := ForEachConcurrent(ctx, []int{1, 2, 3}, 1, func(context.Context, int) error {
+		calls++
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) || calls != 0 {
+		t.Errorf("ForEachConcurrent() = %v after %d calls, expected %v after 0", err, calls, context.Canceled)
+	}
+}
+
+func TestProcessYielding(t *testing.T) {
+	input := countTo(10)
+	chunks := [][]int{}
+	err := ProcessYielding(context.Background(), input, 4, func(chunk []int) error {
+		chunks = append(chunks, chunk)
+		return nil
+	})
+	if expected := [][]int{{0, 1, 2, 3}, {4, 5, 6, 7}, {8, 9}}; err != nil || !reflect.DeepEqual(chunks, expected) {
+		t.Errorf("ProcessYielding() = %v, chunks %v, expected nil, %v", err, chunks, expected)
+	}
+}
+
+func TestProcessYieldingStops(t *testing.T) {
+	failure := errors.New("bad chunk")
+	calls := 0
+	err := ProcessYielding(context.Background(), countTo(10), 3, func(chunk []int) error {
+		calls++
+		if chunk[0] == 3 {
+			return failure
+		}
+		return nil
+	})
+	if !errors.Is(err, failure) || calls != 2 || !strings.HasPrefix(err.Error(), "chunk 1 (elements 3-5): ") {
+		t.Errorf("ProcessYielding() = %v after %d calls, expected chunk 1 to fail after 2 calls", err, calls)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	calls = 0
+	err = ProcessYielding(ctx, countTo(10), 3, func([]int) error {
+		calls++
+		cancel()
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) || calls != 1 {
+		t.Errorf("ProcessYielding() = %v after %d calls, expected %v after 1", err, calls, context.Canceled)
+	}
+}
+
+func TestMapWithRetry(t *testing.T) {
+	errFlaky := errors.New("flaky")
+	calls := make(map[int]int)
+	fn := func(n int) (int, error) {
+		calls[n]++
+		// n fails n times before succeeding
+		if calls[n] <= n {
+			return 0, errFlaky
+		}
+		return n * 10, nil
+	}
+	results, errs := MapWithRetry(context.Background(), []int{0, 1, 2, 5}, 3, time.Millisecond, fn)
+
+	if expected := []int{0, 10, 20, 0}; !reflect.DeepEqual(results, expected) {
+		t.Errorf("MapWithRetry() results = %v, expected %v", results, expected)
+	}
+	if errs[0] != nil || errs[1] != nil || errs[2] != nil || !errors.Is(errs[3], errFlaky) {
+		t.Errorf("MapWithRetry() errors = %v, expected only item 3 to fail", errs)
+	}
+	if expected := map[int]int{0: 1, 1: 2, 2: 3, 5: 3}; !reflect.DeepEqual(calls, expected) {
+		t.Errorf("calls = %v, expected %v", calls, expected)
+	}
+	if err := JoinWithIndices(errs); err == nil || !strings.Contains(err.Error(), "item 3: after 3 attempts: flaky") {
+		t.Errorf("JoinWithIndices(errs) = %v, expected item 3 to be reported", err)
+	}
+}
+
+func TestMapWithRetryBacksOffExponentially(t *testing.T) {
+	var timestamps []time.Time
+	fn := func(int) (int, error) {
+		timestamps = append(timestamps, time.Now())
+		return 0, errors.New("down")
+	}
+	MapWithRetry(context.Background(), []int{1}, 3, 20*time.Millisecond, fn)
+	if len(timestamps) != 3 {
+		t.Fatalf("calls = %d, expected 3", len(timestamps))
+	}
+	if first, second := timestamps[1].Sub(timestamps[0]), timestamps[2].Sub(timestamps[1]); first < 20*time.Millisecond || second < 40*time.Millisecond {
+		t.Errorf("waits = %v, %v, expected at least 20ms then 40ms", first, second)
+	}
+}
+
+func TestMapWithRetryStopsWhenContextIsDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls atomic.Int32
+	fn := func(int) (int, error) {
+		if calls.Add(1) == 1 {
+			time.AfterFunc(10*time.Millisecond, cancel)
+		}
+		return 0, errors.New("down")
+	}
+	_, errs := MapWithRetry(ctx, []int{1, 2, 3}, 5, time.Hour, fn)
+	for i, err := range errs {
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("errs[%d] = %v, expected %v", i, err, context.Canceled)
+		}
+	}
+	if calls.Load() != 1 {
+		t.Errorf("calls = %d, expected 1", calls.Load())
+	}
+}
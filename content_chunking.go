@@ -0,0 +1,61 @@
+package godelin
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"iter"
+	"math/bits"
+)
+
+var gearTable = func() [256]uint64 {
+	var table [256]uint64
+	state := uint64(0x9E3779B97F4A7C15)
+	for i := range table {
+		// splitmix64, so the table and therefore the chunk boundaries are stable across runs
+		state += 0x9E3779B97F4A7C15
+		table[i] = mix64(state)
+	}
+	return table
+}()
+
+func ChunkContentDefined(r io.Reader, avgSize int) iter.Seq2[[]byte, error] {
+	if avgSize <= 0 {
+		panic("ChunkContentDefined: avgSize must be positive")
+	}
+	// The gear hash shifts older bytes towards the top, so only the high bits depend on a
+	// full 64-byte window; testing the low bits would cut on the last few bytes alone.
+	maskBits := bits.Len(uint(avgSize)) - 1
+	mask := (uint64(1)<<maskBits - 1) << (64 - maskBits)
+	minSize := avgSize / 4
+	maxSize := avgSize * 4
+	return func(yield func([]byte, error) bool) {
+		reader := bufio.NewReader(r)
+		// chunk is reused; every yielded chunk is an exact-size copy, so callers that keep
+		// their chunks do not also keep room for maxSize bytes each
+		chunk := make([]byte, 0, maxSize)
+		var hash uint64
+		for {
+			b, err := reader.ReadByte()
+			if err != nil {
+				if len(chunk) > 0 && !yield(bytes.Clone(chunk), nil) {
+					return
+				}
+				if !errors.Is(err, io.EOF) {
+					yield(nil, err)
+				}
+				return
+			}
+			chunk = append(chunk, b)
+			hash = hash<<1 + gearTable[b]
+			if len(chunk) >= maxSize || (len(chunk) >= minSize && hash&mask == 0) {
+				if !yield(bytes.Clone(chunk), nil) {
+					return
+				}
+				chunk = chunk[:0]
+				hash = 0
+			}
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package godelin
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"testing"
+)
+
+func randomBytes(n int, seed uint64) []byte {
+	rng := rand.New(rand.NewPCG(seed, seed))
+	data := make([]byte, n)
+	for i := range data {
+		data[i] = byte(rng.UintN(256))
+	}
+	return data
+}
+
+func collectChunks(t *testing.T, data []byte, avgSize int) [][]byte {
+	t.Helper()
+	chunks := make([][]byte, 0)
+	for chunk, err := range ChunkContentDefined(bytes.NewReader(data), avgSize) {
+		if err != nil {
+			t.Fatalf("ChunkContentDefined() unexpected error: %v", err)
+		}
+		chunks = append(chunks, chunk)
+	}
+	return chunks
+}
+
+func TestChunkContentDefinedReassembles(t *testing.T) {
+	data := randomBytes(64*1024, 1)
+	avgSize := 512
+	chunks := collectChunks(t, data, avgSize)
+	if got := bytes.Join(chunks, nil); !bytes.Equal(got, data) {
+		t.Errorf("ChunkContentDefined() chunks do not reassemble into the input")
+	}
+	for i, chunk := range chunks {
+		// allow for allocator size classes, but not for the maxSize buffer the chunk was cut from
+		if cap(chunk) > len(chunk)+len(chunk)/4+16 {
+			t.Errorf("chunk %d has %d bytes but capacity %d, expected about its own size", i, len(chunk), cap(chunk))
+		}
+		if len(chunk) > avgSize*4 {
+			t.Errorf("chunk %d has %d bytes, expected at most %d", i, len(chunk), avgSize*4)
+		}
+		if i < len(chunks)-1 && len(chunk) < avgSize/4 {
+			t.Errorf("chunk %d has %d bytes, expected at least %d", i, len(chunk), avgSize/4)
+		}
+	}
+}
+
+func TestChunkContentDefinedAverageSize(t *testing.T) {
+	data := randomBytes(1024*1024, 4)
+	for _, avgSize := range []int{256, 1024, 4096} {
+		chunks := collectChunks(t, data, avgSize)
+		average := len(data) / len(chunks)
+		if average < avgSize*3/4 || average > avgSize*3/2 {
+			t.Errorf("ChunkContentDefined(%d) average chunk size = %d, expected close to %d", avgSize, average, avgSize)
+		}
+	}
+}
+
+func TestChunkContentDefinedIsShiftResistant(t *testing.T) {
+	data := randomBytes(64*1024, 2)
+	shifted := append([]byte("inserted prefix"), data...)
+	original := collectChunks(t, data, 256)
+	moved := collectChunks(t, shifted, 256)
+
+	known := make(map[string]struct{}, len(original))
+	for _, chunk := range original {
+		known[string(chunk)] = struct{}{}
+	}
+	shared := len(Filter(moved, func(chunk []byte) bool {
+		_, exists := known[string(chunk)]
+		return exists
+	}))
+	if shared < len(original)*9/10 {
+		t.Errorf("only %d of %d chunks survived a prefix insertion", shared, len(original))
+	}
+}
+
+func TestChunkContentDefinedEmptyInput(t *testing.T) {
+	if chunks := collectChunks(t, []byte{}, 64); len(chunks) != 0 {
+		t.Errorf("ChunkContentDefined() = %v, expected no chunks", chunks)
+	}
+}
+
+type failingReader struct {
+	data []byte
+	err  error
+}
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	if len(r.data) == 0 {
+		return 0, r.err
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+func TestChunkContentDefinedReportsReadErrors(t *testing.T) {
+	failure := errors.New("connection reset")
+	reader := &failingReader{data: []byte("abc"), err: failure}
+	var chunks [][]byte
+	var lastErr error
+	for chunk, err := range ChunkContentDefined(reader, 1024) {
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		chunks = append(chunks, chunk)
+	}
+	if len(chunks) != 1 || string(chunks[0]) != "abc" {
+		t.Errorf("ChunkContentDefined() chunks = %q, expected [abc]", chunks)
+	}
+	if !errors.Is(lastErr, failure) {
+		t.Errorf("ChunkContentDefined() error = %v, expected %v", lastErr, failure)
+	}
+}
+
+func TestChunkContentDefinedStopsEarly(t *testing.T) {
+	count := 0
+	for range ChunkContentDefined(io.LimitReader(bytes.NewReader(randomBytes(8192, 3)), 8192), 64) {
+		count++
+		if count == 2 {
+			break
+		}
+	}
+	if count != 2 {
+		t.Errorf("expected iteration to stop after 2 chunks, got %d", count)
+	}
+}
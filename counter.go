@@ -0,0 +1,68 @@
+package godelin
+
+import (
+	"cmp"
+	"slices"
+)
+
+type Counter[T comparable] struct {
+	counts *OrderedMap[T, int]
+}
+
+func NewCounter[T comparable]() *Counter[T] {
+	return &Counter[T]{counts: NewOrderedMap[T, int]()}
+}
+
+func CounterOf[T comparable](slice []T) *Counter[T] {
+	counter := NewCounter[T]()
+	for _, element := range slice {
+		counter.Add(element, 1)
+	}
+	return counter
+}
+
+// Add changes the count of element by n; elements whose count drops to zero or below are removed.
+func (c *Counter[T]) Add(element T, n int) {
+	count, _ := c.counts.Get(element)
+	if count+n <= 0 {
+		c.counts.Delete(element)
+		return
+	}
+	c.counts.Set(element, count+n)
+}
+
+func (c *Counter[T]) Count(element T) int {
+	count, _ := c.counts.Get(element)
+	return count
+}
+
+func (c *Counter[T]) Len() int {
+	return c.counts.Len()
+}
+
+func (c *Counter[T]) Total() int {
+	return Fold(c.counts.Values(), 0, func(acc, count int) int { return acc + count })
+}
+
+// MostCommon returns the k most frequent elements, ties in first-counted order.
+// A negative k returns every element.
+func (c *Counter[T]) MostCommon(k int) []Pair[T, int] {
+	items := c.counts.Items()
+	slices.SortStableFunc(items, func(a, b Pair[T, int]) int {
+		return cmp.Compare(b.Second, a.Second)
+	})
+	if k < 0 || k > len(items) {
+		return items
+	}
+	return items[:k]
+}
+
+func (c *Counter[T]) Subtract(other *Counter[T]) {
+	for element, count := range other.counts.All() {
+		c.Add(element, -count)
+	}
+}
+
+func (c *Counter[T]) Items() []Pair[T, int] {
+	return c.counts.Items()
+}
@@ -0,0 +1,61 @@
+package godelin
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCounterOf(t *testing.T) {
+	counter := CounterOf(strings.Split("abracadabra", ""))
+	expected := []Pair[string, int]{{"a", 5}, {"b", 2}, {"r", 2}, {"c", 1}, {"d", 1}}
+	if items := counter.Items(); !reflect.DeepEqual(items, expected) {
+		t.Errorf("Items() = %v, expected %v", items, expected)
+	}
+	if counter.Total() != 11 || counter.Len() != 5 {
+		t.Errorf("Total() = %d, Len() = %d, expected 11 and 5", counter.Total(), counter.Len())
+	}
+}
+
+func TestCounterMostCommon(t *testing.T) {
+	counter := CounterOf(strings.Split("abracadabra", ""))
+	testCases := []struct {
+		name     string
+		k        int
+		expected []Pair[string, int]
+	}{
+		{name: "top one", k: 1, expected: []Pair[string, int]{{"a", 5}}},
+		{name: "ties keep first-counted order", k: 3, expected: []Pair[string, int]{{"a", 5}, {"b", 2}, {"r", 2}}},
+		{name: "zero", k: 0, expected: []Pair[string, int]{}},
+		{name: "negative returns all", k: -1, expected: []Pair[string, int]{{"a", 5}, {"b", 2}, {"r", 2}, {"c", 1}, {"d", 1}}},
+		{name: "k beyond length returns all", k: 10, expected: []Pair[string, int]{{"a", 5}, {"b", 2}, {"r", 2}, {"c", 1}, {"d", 1}}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if actual := counter.MostCommon(testCase.k); !reflect.DeepEqual(actual, testCase.expected) {
+				t.Errorf("MostCommon(%d) = %v, expected %v", testCase.k, actual, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestCounterAdd(t *testing.T) {
+	counter := NewCounter[string]()
+	counter.Add("x", 3)
+	counter.Add("x", 2)
+	counter.Add("y", 1)
+	counter.Add("y", -1)
+	if counter.Count("x") != 5 || counter.Count("y") != 0 || counter.Len() != 1 {
+		t.Errorf("counts after Add = %v, expected only x=5", counter.Items())
+	}
+}
+
+func TestCounterSubtract(t *testing.T) {
+	counter := CounterOf([]string{"a", "a", "a", "b", "c"})
+	counter.Subtract(CounterOf([]string{"a", "b", "b", "d"}))
+	expected := []Pair[string, int]{{"a", 2}, {"c", 1}}
+	if items := counter.Items(); !reflect.DeepEqual(items, expected) {
+		t.Errorf("Items() after Subtract = %v, expected %v", items, expected)
+	}
+}
@@ -0,0 +1,90 @@
+package godelin
+
+import "reflect"
+
+type SliceMergeMode int
+
+const (
+	SliceReplace SliceMergeMode = iota
+	SliceAppend
+	SliceUnion
+)
+
+type ScalarMergeMode int
+
+const (
+	ScalarOverride ScalarMergeMode = iota
+	ScalarKeep
+)
+
+type MergeStrategy struct {
+	Slices  SliceMergeMode
+	Scalars ScalarMergeMode
+}
+
+// DeepMerge layers src over dst and returns a new document; neither input is modified.
+// Nested map[string]any values are merged recursively, []any values follow strategy.Slices
+// and everything else, including values whose types differ, follows strategy.Scalars.
+func DeepMerge(dst, src map[string]any, strategy MergeStrategy) map[string]any {
+	result := deepCopyDocument(dst)
+	for key, srcValue := range src {
+		dstValue, exists := result[key]
+		if !exists {
+			result[key] = deepCopyValue(srcValue)
+			continue
+		}
+		result[key] = mergeValues(dstValue, srcValue, strategy)
+	}
+	return result
+}
+
+func mergeValues(dstValue, srcValue any, strategy MergeStrategy) any {
+	switch dstTyped := dstValue.(type) {
+	case map[string]any:
+		if srcTyped, ok := srcValue.(map[string]any); ok {
+			return DeepMerge(dstTyped, srcTyped, strategy)
+		}
+	case []any:
+		if srcTyped, ok := srcValue.([]any); ok {
+			return mergeSlices(dstTyped, srcTyped, strategy.Slices)
+		}
+	}
+	if strategy.Scalars == ScalarKeep {
+		return dstValue
+	}
+	return deepCopyValue(srcValue)
+}
+
+func mergeSlices(dst, src []any, mode SliceMergeMode) []any {
+	switch mode {
+	case SliceAppend:
+		return Map(Plus(dst, src...), deepCopyValue)
+	case SliceUnion:
+		result := make([]any, 0, len(dst)+len(src))
+		for _, element := range Plus(dst, src...) {
+			if !Any(result, func(existing any) bool { return reflect.DeepEqual(existing, element) }) {
+				result = append(result, deepCopyValue(element))
+			}
+		}
+		return result
+	default:
+		return Map(src, deepCopyValue)
+	}
+}
+
+func deepCopyDocument(document map[string]any) map[string]any {
+	return MapEntries(document, func(key string, value any) (string, any) {
+		return key, deepCopyValue(value)
+	})
+}
+
+func deepCopyValue(value any) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		return deepCopyDocument(typed)
+	case []any:
+		return Map(typed, deepCopyValue)
+	default:
+		return value
+	}
+}
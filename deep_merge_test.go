@@ -0,0 +1,115 @@
+package godelin
+
+import (
+	"reflect"
+	"testing"
+)
+
+func baseDocument() map[string]any {
+	return map[string]any{
+		"name": "service",
+		"db": map[string]any{
+			"host": "localhost",
+			"port": 5432,
+		},
+		"tags":    []any{"a", "b"},
+		"replica": map[string]any{"count": 1},
+	}
+}
+
+func overlayDocument() map[string]any {
+	return map[string]any{
+		"db": map[string]any{
+			"host": "db.internal",
+			"pool": map[string]any{"max": 10},
+		},
+		"tags":    []any{"b", "c"},
+		"replica": 3,
+		"debug":   true,
+	}
+}
+
+func TestDeepMerge(t *testing.T) {
+	testCases := []struct {
+		name     string
+		strategy MergeStrategy
+		expected map[string]any
+	}{
+		{
+			name:     "override scalars and replace slices",
+			strategy: MergeStrategy{},
+			expected: map[string]any{
+				"name":    "service",
+				"db":      map[string]any{"host": "db.internal", "port": 5432, "pool": map[string]any{"max": 10}},
+				"tags":    []any{"b", "c"},
+				"replica": 3,
+				"debug":   true,
+			},
+		},
+		{
+			name:     "keep scalars and append slices",
+			strategy: MergeStrategy{Slices: SliceAppend, Scalars: ScalarKeep},
+			expected: map[string]any{
+				"name":    "service",
+				"db":      map[string]any{"host": "localhost", "port": 5432, "pool": map[string]any{"max": 10}},
+				"tags":    []any{"a", "b", "b", "c"},
+				"replica": map[string]any{"count": 1},
+				"debug":   true,
+			},
+		},
+		{
+			name:     "union slices",
+			strategy: MergeStrategy{Slices: SliceUnion},
+			expected: map[string]any{
+				"name":    "service",
+				"db":      map[string]any{"host": "db.internal", "port": 5432, "pool": map[string]any{"max": 10}},
+				"tags":    []any{"a", "b", "c"},
+				"replica": 3,
+				"debug":   true,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			actual := DeepMerge(baseDocument(), overlayDocument(), testCase.strategy)
+			if !reflect.DeepEqual(actual, testCase.expected) {
+				t.Errorf("DeepMerge() = %v, expected %v", actual, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestDeepMergeDoesNotModifyInputs(t *testing.T) {
+	dst, src := baseDocument(), overlayDocument()
+	merged := DeepMerge(dst, src, MergeStrategy{Slices: SliceAppend})
+	merged["db"].(map[string]any)["host"] = "changed"
+	merged["db"].(map[string]any)["pool"].(map[string]any)["max"] = 0
+	merged["tags"].([]any)[0] = "changed"
+
+	if !reflect.DeepEqual(dst, baseDocument()) {
+		t.Errorf("DeepMerge() result shares state with dst: %v", dst)
+	}
+	if !reflect.DeepEqual(src, overlayDocument()) {
+		t.Errorf("DeepMerge() result shares state with src: %v", src)
+	}
+}
+
+func TestDeepMergeUnionWithNestedElements(t *testing.T) {
+	dst := map[string]any{"rules": []any{map[string]any{"allow": "a"}}}
+	src := map[string]any{"rules": []any{map[string]any{"allow": "a"}, map[string]any{"allow": "b"}}}
+	actual := DeepMerge(dst, src, MergeStrategy{Slices: SliceUnion})
+	expected := map[string]any{"rules": []any{map[string]any{"allow": "a"}, map[string]any{"allow": "b"}}}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("DeepMerge() = %v, expected %v", actual, expected)
+	}
+}
+
+func TestDeepMergeNilInputs(t *testing.T) {
+	if actual := DeepMerge(nil, nil, MergeStrategy{}); actual == nil || len(actual) != 0 {
+		t.Errorf("DeepMerge(nil, nil) = %#v, expected an empty map", actual)
+	}
+	if actual := DeepMerge(nil, map[string]any{"a": 1}, MergeStrategy{}); !reflect.DeepEqual(actual, map[string]any{"a": 1}) {
+		t.Errorf("DeepMerge(nil, src) = %v, expected map[a:1]", actual)
+	}
+}
@@ -0,0 +1,46 @@
+package godelin
+
+import "maps"
+
+type DefaultMap[K comparable, V any] struct {
+	entries      map[K]V
+	defaultValue func(K) V
+}
+
+func NewDefaultMap[K comparable, V any](defaultValue func(K) V) *DefaultMap[K, V] {
+	return &DefaultMap[K, V]{entries: make(map[K]V), defaultValue: defaultValue}
+}
+
+// Get returns the value for key, inserting the factory's default first when the key is missing.
+func (d *DefaultMap[K, V]) Get(key K) V {
+	value, _ := GetOrPut(d.entries, key, d.defaultValue)
+	return value
+}
+
+// Lookup returns the value for key without inserting a default.
+func (d *DefaultMap[K, V]) Lookup(key K) (V, bool) {
+	value, exists := d.entries[key]
+	return value, exists
+}
+
+func (d *DefaultMap[K, V]) Set(key K, value V) {
+	d.entries[key] = value
+}
+
+func (d *DefaultMap[K, V]) Update(key K, transform func(V) V) V {
+	value := transform(d.Get(key))
+	d.entries[key] = value
+	return value
+}
+
+func (d *DefaultMap[K, V]) Delete(key K) {
+	delete(d.entries, key)
+}
+
+func (d *DefaultMap[K, V]) Len() int {
+	return len(d.entries)
+}
+
+func (d *DefaultMap[K, V]) ToMap() map[K]V {
+	return maps.Clone(d.entries)
+}
@@ -0,0 +1,66 @@
+package godelin
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDefaultMapCounter(t *testing.T) {
+	counts := NewDefaultMap(func(string) int { return 0 })
+	for _, word := range strings.Fields("the cat and the hat and the bat") {
+		counts.Update(word, func(n int) int { return n + 1 })
+	}
+	expected := map[string]int{"the": 3, "cat": 1, "and": 2, "hat": 1, "bat": 1}
+	if actual := counts.ToMap(); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("ToMap() = %v, expected %v", actual, expected)
+	}
+}
+
+func TestDefaultMapAccumulator(t *testing.T) {
+	groups := NewDefaultMap(func(string) []string { return []string{} })
+	for _, word := range []string{"apple", "banana", "avocado"} {
+		key := word[:1]
+		groups.Set(key, append(groups.Get(key), word))
+	}
+	expected := map[string][]string{"a": {"apple", "avocado"}, "b": {"banana"}}
+	if actual := groups.ToMap(); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("ToMap() = %v, expected %v", actual, expected)
+	}
+}
+
+func TestDefaultMapGetInsertsUsingKey(t *testing.T) {
+	calls := 0
+	lengths := NewDefaultMap(func(key string) int {
+		calls++
+		return len(key)
+	})
+	if lengths.Get("four") != 4 || lengths.Get("four") != 4 || calls != 1 {
+		t.Errorf("Get() called the factory %d times, expected 1", calls)
+	}
+	if lengths.Len() != 1 {
+		t.Errorf("Len() = %d, expected 1", lengths.Len())
+	}
+}
+
+func TestDefaultMapLookupDoesNotInsert(t *testing.T) {
+	d := NewDefaultMap(func(string) int { return 7 })
+	if value, found := d.Lookup("a"); value != 0 || found || d.Len() != 0 {
+		t.Errorf("Lookup() = %v, %v with Len %d, expected 0, false with Len 0", value, found, d.Len())
+	}
+	d.Get("a")
+	d.Delete("a")
+	if _, found := d.Lookup("a"); found {
+		t.Errorf("Delete() did not remove the key")
+	}
+}
+
+func TestDefaultMapToMapCopies(t *testing.T) {
+	d := NewDefaultMap(func(string) int { return 1 })
+	d.Get("a")
+	copied := d.ToMap()
+	copied["a"] = 100
+	if value, _ := d.Lookup("a"); value != 1 {
+		t.Errorf("ToMap() shares storage with the DefaultMap")
+	}
+}
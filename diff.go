@@ -0,0 +1,128 @@
+package godelin
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+var ErrPatchMismatch = errors.New("patch does not apply")
+
+type EditOp int
+
+const (
+	EditKeep EditOp = iota
+	EditDelete
+	EditInsert
+)
+
+func (op EditOp) String() string {
+	switch op {
+	case EditKeep:
+		return "keep"
+	case EditDelete:
+		return "delete"
+	case EditInsert:
+		return "insert"
+	}
+	return fmt.Sprintf("EditOp(%d)", int(op))
+}
+
+// Edit is one step of an edit script: Keep and Delete consume the next element of the old
+// slice, Insert adds Value to the new one.
+type Edit[T any] struct {
+	Op    EditOp
+	Value T
+}
+
+// Diff returns a shortest edit script turning old into new, using Myers' O((n+m)·d)
+// algorithm, where d is the number of inserted and deleted elements. Backtracking keeps
+// O(d²) frontier values, independent of the input lengths.
+func Diff[T comparable](old, new []T) []Edit[T] {
+	offset := len(old) + len(new) + 1
+	frontier := make([]int, 2*offset+1)
+	// trace[d] holds diagonals -d..d of the frontier as it was before round d, the only ones
+	// round d reads and therefore the only ones backtracking needs.
+	trace := make([][]int, 0)
+	for d := 0; ; d++ {
+		trace = append(trace, slices.Clone(frontier[offset-d:offset+d+1]))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && frontier[offset+k-1] < frontier[offset+k+1]) {
+				x = frontier[offset+k+1]
+			} else {
+				x = frontier[offset+k-1] + 1
+			}
+			y := x - k
+			for x < len(old) && y < len(new) && old[x] == new[y] {
+				x, y = x+1, y+1
+			}
+			frontier[offset+k] = x
+			if x >= len(old) && y >= len(new) {
+				return backtrackEdits(old, new, trace)
+			}
+		}
+	}
+}
+
+func backtrackEdits[T any](old, new []T, trace [][]int) []Edit[T] {
+	edits := make([]Edit[T], 0, max(len(old), len(new)))
+	x, y := len(old), len(new)
+	for d := len(trace) - 1; d >= 0; d-- {
+		// diagonal k of this round's frontier is stored at index d+k
+		frontier := trace[d]
+		k := x - y
+		previousK := k - 1
+		if k == -d || (k != d && frontier[d+k-1] < frontier[d+k+1]) {
+			previousK = k + 1
+		}
+		previousX := 0
+		if d > 0 {
+			previousX = frontier[d+previousK]
+		}
+		previousY := previousX - previousK
+		for x > previousX && y > previousY {
+			edits = append(edits, Edit[T]{Op: EditKeep, Value: old[x-1]})
+			x, y = x-1, y-1
+		}
+		if d == 0 {
+			break
+		}
+		if x == previousX {
+			edits = append(edits, Edit[T]{Op: EditInsert, Value: new[y-1]})
+		} else {
+			edits = append(edits, Edit[T]{Op: EditDelete, Value: old[x-1]})
+		}
+		x, y = previousX, previousY
+	}
+	slices.Reverse(edits)
+	return edits
+}
+
+// Patch applies edits to old. It fails with ErrPatchMismatch when a Keep or Delete does not
+// match the element it consumes, or when the script does not consume all of old.
+func Patch[T comparable](old []T, edits []Edit[T]) ([]T, error) {
+	result := make([]T, 0, len(old))
+	position := 0
+	for i, edit := range edits {
+		switch edit.Op {
+		case EditInsert:
+			result = append(result, edit.Value)
+			continue
+		case EditKeep, EditDelete:
+		default:
+			return nil, fmt.Errorf("edit %d: %w: unknown operation %v", i, ErrPatchMismatch, edit.Op)
+		}
+		if position >= len(old) || old[position] != edit.Value {
+			return nil, fmt.Errorf("edit %d (%v %v): %w at position %d", i, edit.Op, edit.Value, ErrPatchMismatch, position)
+		}
+		if edit.Op == EditKeep {
+			result = append(result, edit.Value)
+		}
+		position++
+	}
+	if position != len(old) {
+		return nil, fmt.Errorf("%w: %d trailing elements not covered by the script", ErrPatchMismatch, len(old)-position)
+	}
+	return result, nil
+}
@@ -0,0 +1,118 @@
+package godelin
+
+import (
+	"errors"
+	"math/rand/v2"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	keep := func(s string) Edit[string] { return Edit[string]{EditKeep, s} }
+	del := func(s string) Edit[string] { return Edit[string]{EditDelete, s} }
+	ins := func(s string) Edit[string] { return Edit[string]{EditInsert, s} }
+	testCases := []struct {
+		name     string
+		old, new string
+		expected []Edit[string]
+	}{
+		{"identical", "abc", "abc", []Edit[string]{keep("a"), keep("b"), keep("c")}},
+		{"replace middle", "abc", "axc", []Edit[string]{keep("a"), del("b"), ins("x"), keep("c")}},
+		{"from empty", "", "ab", []Edit[string]{ins("a"), ins("b")}},
+		{"to empty", "ab", "", []Edit[string]{del("a"), del("b")}},
+		{"both empty", "", "", []Edit[string]{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := Diff(strings.Split(tc.old, ""), strings.Split(tc.new, ""))
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("Diff(%q, %q) = %v, expected %v", tc.old, tc.new, got, tc.expected)
+			}
+		})
+	}
+}
+
+// lcsLength is the textbook dynamic program, used to check that Diff's scripts are minimal.
+func lcsLength(a, b []int) int {
+	table := make([][]int, len(a)+1)
+	for i := range table {
+		table[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				table[i][j] = table[i+1][j+1] + 1
+			} else {
+				table[i][j] = max(table[i+1][j], table[i][j+1])
+			}
+		}
+	}
+	return table[0][0]
+}
+
+func TestDiffPatchRoundTripIsMinimal(t *testing.T) {
+	rng := rand.New(rand.NewPCG(5, 5))
+	randomInts := func() []int {
+		return Iterate(rng.IntN(30), func(int) int { return rng.IntN(5) })
+	}
+	for range 300 {
+		old, new := randomInts(), randomInts()
+		edits := Diff(old, new)
+		patched, err := Patch(old, edits)
+		if err != nil || !reflect.DeepEqual(patched, new) {
+			t.Fatalf("Patch(%v, Diff(%v, %v)) = %v, %v", old, old, new, patched, err)
+		}
+		changes := len(Filter(edits, func(e Edit[int]) bool { return e.Op != EditKeep }))
+		if expected := len(old) + len(new) - 2*lcsLength(old, new); changes != expected {
+			t.Fatalf("Diff(%v, %v) has %d changes, expected the minimum %d", old, new, changes, expected)
+		}
+	}
+}
+
+func TestDiffLargeInputAllocations(t *testing.T) {
+	rng := rand.New(rand.NewPCG(6, 6))
+	old := Iterate(20_000, func(i int) int { return i })
+	new := make([]int, 0, len(old))
+	for _, value := range old {
+		switch rng.IntN(40) {
+		case 0: // drop the value
+		case 1:
+			new = append(new, -value, value)
+		default:
+			new = append(new, value)
+		}
+	}
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	edits := Diff(old, new)
+	runtime.ReadMemStats(&after)
+
+	if patched, err := Patch(old, edits); err != nil || !reflect.DeepEqual(patched, new) {
+		t.Fatalf("Patch(old, Diff(old, new)) does not reproduce new: %v", err)
+	}
+	// about 1000 edits: the O(d²) trace takes under 10 MB, a full frontier per round about 600 MB
+	if allocated := after.TotalAlloc - before.TotalAlloc; allocated > 32<<20 {
+		t.Errorf("Diff() allocated %d MB for %d elements, expected at most 32 MB", allocated>>20, len(old))
+	}
+}
+
+func TestPatchRejectsMismatchedScripts(t *testing.T) {
+	testCases := []struct {
+		name  string
+		edits []Edit[string]
+	}{
+		{"wrong kept value", []Edit[string]{{EditKeep, "x"}, {EditKeep, "b"}}},
+		{"script too short", []Edit[string]{{EditKeep, "a"}}},
+		{"script too long", []Edit[string]{{EditKeep, "a"}, {EditKeep, "b"}, {EditDelete, "c"}}},
+		{"unknown operation", []Edit[string]{{EditOp(9), "a"}, {EditKeep, "b"}}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := Patch([]string{"a", "b"}, tc.edits); !errors.Is(err, ErrPatchMismatch) {
+				t.Errorf("Patch() error = %v, expected %v", err, ErrPatchMismatch)
+			}
+		})
+	}
+}
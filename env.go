@@ -0,0 +1,47 @@
+package godelin
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func FromEnviron(environ []string) map[string]string {
+	result := make(map[string]string, len(environ))
+	for _, entry := range environ {
+		if key, value, found := strings.Cut(entry, "="); found && key != "" {
+			result[key] = value
+		}
+	}
+	return result
+}
+
+// FilterEnvPrefix keeps the variables starting with prefix and strips the prefix from their names.
+func FilterEnvPrefix(env map[string]string, prefix string) map[string]string {
+	return StripKeyPrefix(env, prefix)
+}
+
+func EnvInt(env map[string]string, key string, fallback int) (int, error) {
+	return parseEnv(env, key, fallback, strconv.Atoi)
+}
+
+func EnvBool(env map[string]string, key string, fallback bool) (bool, error) {
+	return parseEnv(env, key, fallback, strconv.ParseBool)
+}
+
+func EnvDuration(env map[string]string, key string, fallback time.Duration) (time.Duration, error) {
+	return parseEnv(env, key, fallback, time.ParseDuration)
+}
+
+func parseEnv[T any](env map[string]string, key string, fallback T, parse func(string) (T, error)) (T, error) {
+	raw, exists := env[key]
+	if !exists || raw == "" {
+		return fallback, nil
+	}
+	value, err := parse(raw)
+	if err != nil {
+		return fallback, fmt.Errorf("env %s: %w", key, err)
+	}
+	return value, nil
+}
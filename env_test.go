@@ -0,0 +1,73 @@
+package godelin
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFromEnviron(t *testing.T) {
+	environ := []string{"HOME=/root", "EMPTY=", "EQUATION=a=b", "NOVALUE", "=hidden", "HOME=/home/user"}
+	actual := FromEnviron(environ)
+	expected := map[string]string{"HOME": "/home/user", "EMPTY": "", "EQUATION": "a=b"}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("FromEnviron() = %v, expected %v", actual, expected)
+	}
+}
+
+func TestFilterEnvPrefix(t *testing.T) {
+	env := map[string]string{"APP_PORT": "8080", "APP_DEBUG": "true", "PATH": "/bin"}
+	actual := FilterEnvPrefix(env, "APP_")
+	expected := map[string]string{"PORT": "8080", "DEBUG": "true"}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("FilterEnvPrefix() = %v, expected %v", actual, expected)
+	}
+}
+
+func TestEnvInt(t *testing.T) {
+	env := map[string]string{"PORT": "8080", "BAD": "eighty", "BLANK": ""}
+	testCases := []struct {
+		name      string
+		key       string
+		expected  int
+		expectErr bool
+	}{
+		{name: "parsed", key: "PORT", expected: 8080},
+		{name: "missing uses fallback", key: "MISSING", expected: 1},
+		{name: "blank uses fallback", key: "BLANK", expected: 1},
+		{name: "invalid reports error", key: "BAD", expected: 1, expectErr: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			actual, err := EnvInt(env, testCase.key, 1)
+			if actual != testCase.expected || (err != nil) != testCase.expectErr {
+				t.Errorf("EnvInt() = %v, %v, expected %v (error: %v)", actual, err, testCase.expected, testCase.expectErr)
+			}
+		})
+	}
+}
+
+func TestEnvBool(t *testing.T) {
+	env := map[string]string{"DEBUG": "true", "BAD": "yes please"}
+	if actual, err := EnvBool(env, "DEBUG", false); !actual || err != nil {
+		t.Errorf("EnvBool() = %v, %v, expected true, nil", actual, err)
+	}
+	actual, err := EnvBool(env, "BAD", false)
+	if actual || err == nil || err.Error() != `env BAD: strconv.ParseBool: parsing "yes please": invalid syntax` {
+		t.Errorf("EnvBool() = %v, %v, expected false and a parse error", actual, err)
+	}
+}
+
+func TestEnvDuration(t *testing.T) {
+	env := map[string]string{"TIMEOUT": "1m30s", "BAD": "soon"}
+	if actual, err := EnvDuration(env, "TIMEOUT", time.Second); actual != 90*time.Second || err != nil {
+		t.Errorf("EnvDuration() = %v, %v, expected 1m30s, nil", actual, err)
+	}
+	if actual, err := EnvDuration(env, "MISSING", time.Second); actual != time.Second || err != nil {
+		t.Errorf("EnvDuration() = %v, %v, expected 1s, nil", actual, err)
+	}
+	if actual, err := EnvDuration(env, "BAD", time.Second); actual != time.Second || err == nil {
+		t.Errorf("EnvDuration() = %v, %v, expected 1s and an error", actual, err)
+	}
+}
@@ -0,0 +1,24 @@
+package godelin
+
+import (
+	"errors"
+	"fmt"
+)
+
+func CompactErrors(errs []error) []error {
+	return Filter(errs, func(err error) bool { return err != nil })
+}
+
+func AnyError(errs []error) bool {
+	return Any(errs, func(err error) bool { return err != nil })
+}
+
+func JoinWithIndices(errs []error) error {
+	indexed := make([]error, 0, len(errs))
+	for i, err := range errs {
+		if err != nil {
+			indexed = append(indexed, fmt.Errorf("item %d: %w", i, err))
+		}
+	}
+	return errors.Join(indexed...)
+}
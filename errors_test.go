@@ -0,0 +1,53 @@
+package godelin
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCompactErrors(t *testing.T) {
+	first, second := errors.New("first"), errors.New("second")
+	testCases := []struct {
+		name     string
+		errs     []error
+		expected []error
+	}{
+		{name: "drops nils", errs: []error{nil, first, nil, second}, expected: []error{first, second}},
+		{name: "all nil", errs: []error{nil, nil}, expected: []error{}},
+		{name: "empty", errs: []error{}, expected: []error{}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			actual := CompactErrors(testCase.errs)
+			if !reflect.DeepEqual(actual, testCase.expected) {
+				t.Errorf("CompactErrors() = %v, expected %v", actual, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestAnyError(t *testing.T) {
+	if AnyError([]error{nil, nil}) {
+		t.Errorf("AnyError() = true for only nil errors")
+	}
+	if !AnyError([]error{nil, errors.New("failed")}) {
+		t.Errorf("AnyError() = false with a non-nil error")
+	}
+}
+
+func TestJoinWithIndices(t *testing.T) {
+	timeout := errors.New("timeout")
+	err := JoinWithIndices([]error{nil, timeout, nil, errors.New("not found")})
+	expected := "item 1: timeout\nitem 3: not found"
+	if err == nil || err.Error() != expected {
+		t.Errorf("JoinWithIndices() = %v, expected %q", err, expected)
+	}
+	if !errors.Is(err, timeout) {
+		t.Errorf("JoinWithIndices() does not wrap the original errors")
+	}
+	if err := JoinWithIndices([]error{nil, nil}); err != nil {
+		t.Errorf("JoinWithIndices() = %v, expected nil", err)
+	}
+}
@@ -0,0 +1,67 @@
+package godelin
+
+import "iter"
+
+// GenerateSequence returns seed followed by the values produced by repeatedly applying next to
+// the previous value, stopping at the first call that returns false.
+func GenerateSequence[T any](seed T, next func(T) (T, bool)) []T {
+	result := make([]T, 0)
+	for value := range GenerateSequenceSeq(seed, next) {
+		result = append(result, value)
+	}
+	return result
+}
+
+// GenerateSequenceSeq is the lazy form of GenerateSequence; it may be infinite if next never
+// returns false.
+func GenerateSequenceSeq[T any](seed T, next func(T) (T, bool)) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for value, ok := seed, true; ok; value, ok = next(value) {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Iterate returns fn(0), fn(1), ..., fn(n-1).
+func Iterate[T any](n int, fn func(int) T) []T {
+	if n < 0 {
+		panic("Iterate: n must not be negative")
+	}
+	result := make([]T, n)
+	for i := range result {
+		result[i] = fn(i)
+	}
+	return result
+}
+
+func IterateSeq[T any](n int, fn func(int) T) iter.Seq[T] {
+	if n < 0 {
+		panic("IterateSeq: n must not be negative")
+	}
+	return func(yield func(T) bool) {
+		for i := range n {
+			if !yield(fn(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Unfold is the dual of Fold: starting from seed, step produces an element and the next state
+// until it returns false. The element returned alongside false is discarded.
+func Unfold[S, T any](seed S, step func(S) (T, S, bool)) []T {
+	return UnfoldTo(seed, make([]T, 0), step)
+}
+
+func UnfoldTo[S, T any](seed S, destination []T, step func(S) (T, S, bool)) []T {
+	for state := seed; ; {
+		element, next, ok := step(state)
+		if !ok {
+			return EmptyIfNil(destination)
+		}
+		destination = append(destination, element)
+		state = next
+	}
+}
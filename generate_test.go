@@ -0,0 +1,110 @@
+package godelin
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestGenerateSequence(t *testing.T) {
+	testCases := []struct {
+		name     string
+		seed     int
+		next     func(int) (int, bool)
+		expected []int
+	}{
+		{"collatz", 6, func(n int) (int, bool) {
+			if n == 1 {
+				return 0, false
+			}
+			if n%2 == 0 {
+				return n / 2, true
+			}
+			return 3*n + 1, true
+		}, []int{6, 3, 10, 5, 16, 8, 4, 2, 1}},
+		{"seed only", 5, func(int) (int, bool) { return 0, false }, []int{5}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := GenerateSequence(tc.seed, tc.next); !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("GenerateSequence() = %v, expected %v", got, tc.expected)
+			}
+			if got := slices.Collect(GenerateSequenceSeq(tc.seed, tc.next)); !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("GenerateSequenceSeq() = %v, expected %v", got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestGenerateSequenceSeqInfinite(t *testing.T) {
+	powers := GenerateSequenceSeq(1, func(n int) (int, bool) { return n * 2, true })
+	if got, expected := slices.Collect(TakeSeq(powers, 5)), []int{1, 2, 4, 8, 16}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("TakeSeq(GenerateSequenceSeq(), 5) = %v, expected %v", got, expected)
+	}
+}
+
+func TestUnfold(t *testing.T) {
+	fibonacci := func(state [2]int) (int, [2]int, bool) {
+		return state[0], [2]int{state[1], state[0] + state[1]}, state[0] < 50
+	}
+	if got, expected := Unfold([2]int{0, 1}, fibonacci), []int{0, 1, 1, 2, 3, 5, 8, 13, 21, 34}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("Unfold() = %v, expected %v", got, expected)
+	}
+
+	pages := map[string][]string{"": {"a", "b"}, "p2": {"c"}}
+	cursors := map[string]string{"": "p2"}
+	fetch := func(cursor *string) ([]string, *string, bool) {
+		if cursor == nil {
+			return nil, nil, false
+		}
+		items := pages[*cursor]
+		if next, ok := cursors[*cursor]; ok {
+			return items, &next, true
+		}
+		return items, nil, true
+	}
+	start := ""
+	if got, expected := Unfold(&start, fetch), [][]string{{"a", "b"}, {"c"}}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("Unfold(pages) = %v, expected %v", got, expected)
+	}
+
+	if got := Unfold(0, func(int) (int, int, bool) { return 1, 0, false }); !reflect.DeepEqual(got, []int{}) {
+		t.Errorf("Unfold(stop) = %v, expected []", got)
+	}
+}
+
+func TestUnfoldTo(t *testing.T) {
+	countdown := func(n int) (int, int, bool) { return n, n - 1, n > 0 }
+	if got, expected := UnfoldTo(3, []int{9}, countdown), []int{9, 3, 2, 1}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("UnfoldTo() = %v, expected %v", got, expected)
+	}
+	if got := UnfoldTo(0, nil, countdown); !reflect.DeepEqual(got, []int{}) {
+		t.Errorf("UnfoldTo(nil) = %v, expected []", got)
+	}
+}
+
+func TestIterate(t *testing.T) {
+	square := func(i int) int { return i * i }
+	if got, expected := Iterate(4, square), []int{0, 1, 4, 9}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("Iterate(4) = %v, expected %v", got, expected)
+	}
+	if got := Iterate(0, square); !reflect.DeepEqual(got, []int{}) {
+		t.Errorf("Iterate(0) = %v, expected []", got)
+	}
+	calls := 0
+	for range IterateSeq(100, func(i int) int { calls++; return i }) {
+		if calls == 2 {
+			break
+		}
+	}
+	if calls != 2 {
+		t.Errorf("IterateSeq() called fn %d times, expected 2", calls)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected a panic but did not get one")
+		}
+	}()
+	Iterate(-1, square)
+}
@@ -1,10 +1,40 @@
 package godelin
 
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
 type Pair[F, S any] struct {
 	First  F
 	Second S
 }
 
+// Key2 is a comparable composite key for maps, for use where Pair's unconstrained type
+// parameters would make it unusable as a map key.
+type Key2[A, B comparable] struct {
+	First  A
+	Second B
+}
+
+type Key3[A, B, C comparable] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+func NewKey2[A, B comparable](first A, second B) Key2[A, B] {
+	return Key2[A, B]{First: first, Second: second}
+}
+
+func NewKey3[A, B, C comparable](first A, second B, third C) Key3[A, B, C] {
+	return Key3[A, B, C]{First: first, Second: second, Third: third}
+}
+
 func All[T any](slice []T, predicate func(T) bool) bool {
 	for _, element := range slice {
 		if !predicate(element) {
@@ -23,24 +53,156 @@ func Any[T any](slice []T, predicate func(T) bool) bool {
 	return false
 }
 
-func GetOrPut[M ~map[K]V, K comparable, V any](m M, key K, defaultValue func(K) V) V {
+func ForEach[T any](slice []T, action func(T)) {
+	for _, element := range slice {
+		action(element)
+	}
+}
+
+func ForEachIndexed[T any](slice []T, action func(int, T)) {
+	for i, element := range slice {
+		action(i, element)
+	}
+}
+
+// GetOrPut returns the value stored under key, computing and storing it first when absent.
+// loaded reports whether the value was already present. Like any map write, it panics on a
+// nil map; use GetOrPutSafe for maps that may not have been initialized yet.
+func GetOrPut[M ~map[K]V, K comparable, V any](m M, key K, defaultValue func(K) V) (value V, loaded bool) {
 	if value, exists := m[key]; exists {
-		return value
+		return value, true
 	}
 	newValue := defaultValue(key)
 	m[key] = newValue
-	return newValue
+	return newValue, false
+}
+
+// GetOrPutSafe is GetOrPut for a map held by pointer, typically a struct field; a nil map is
+// allocated before the value is stored.
+func GetOrPutSafe[M ~map[K]V, K comparable, V any](m *M, key K, defaultValue func(K) V) (value V, loaded bool) {
+	if *m == nil {
+		*m = make(M)
+	}
+	return GetOrPut(*m, key, defaultValue)
+}
+
+func Associate[T any, K comparable, V any](slice []T, transform func(T) (K, V)) map[K]V {
+	return AssociateTo(slice, make(map[K]V, len(slice)), transform)
+}
+
+func AssociateTo[M ~map[K]V, T any, K comparable, V any](slice []T, destination M, transform func(T) (K, V)) M {
+	if destination == nil {
+		destination = make(M, len(slice))
+	}
+	for _, element := range slice {
+		key, value := transform(element)
+		destination[key] = value
+	}
+	return destination
+}
+
+var ErrNilMap = errors.New("assignment to entry in nil map")
+
+func NilMapSafeGetOrPut[M ~map[K]V, K comparable, V any](m M, key K, defaultValue func(K) V) (V, error) {
+	if m == nil {
+		var zero V
+		return zero, ErrNilMap
+	}
+	value, _ := GetOrPut(m, key, defaultValue)
+	return value, nil
 }
 
 func GroupBy[T any, K comparable, V any](slice []T, transform func(T) (K, V)) map[K][]V {
-	result := make(map[K][]V, len(slice))
+	return GroupByTo(slice, make(map[K][]V, len(slice)), transform)
+}
+
+// GroupByTwoPass is GroupBy with every group sized exactly before it is filled: the first pass
+// records each element's group, the second copies the values into one shared backing array.
+// It avoids GroupBy's append growth when groups are large, at the cost of three slices as long
+// as the input, and any one group keeps the whole backing array alive. Each group is
+// capacity-capped, so appending to one reallocates instead of overwriting its neighbour.
+func GroupByTwoPass[T any, K comparable, V any](slice []T, transform func(T) (K, V)) map[K][]V {
+	type group struct {
+		key         K
+		start, size int
+	}
+	groupOf := make([]int, len(slice))
+	values := make([]V, len(slice))
+	indices := make(map[K]int)
+	groups := make([]group, 0, 8)
+	for i, element := range slice {
+		key, value := transform(element)
+		index, exists := indices[key]
+		if !exists {
+			index = len(groups)
+			indices[key] = index
+			groups = append(groups, group{key: key})
+		}
+		groupOf[i] = index
+		values[i] = value
+		groups[index].size++
+	}
+	start := 0
+	for i := range groups {
+		groups[i].start = start
+		start += groups[i].size
+		groups[i].size = 0 // reused as the group's fill count
+	}
+	backing := make([]V, len(slice))
+	for i, index := range groupOf {
+		group := &groups[index]
+		backing[group.start+group.size] = values[i]
+		group.size++
+	}
+	result := make(map[K][]V, len(groups))
+	for _, group := range groups {
+		end := group.start + group.size
+		result[group.key] = backing[group.start:end:end]
+	}
+	return result
+}
+
+func GroupByTo[M ~map[K][]V, T any, K comparable, V any](slice []T, destination M, transform func(T) (K, V)) M {
+	if destination == nil {
+		destination = make(M, len(slice))
+	}
 	for _, element := range slice {
 		key, value := transform(element)
-		result[key] = append(result[key], value)
+		destination[key] = append(destination[key], value)
+	}
+	return destination
+}
+
+// GroupBy2 groups elements by an outer and then an inner key, e.g. region then service.
+func GroupBy2[T any, K1, K2 comparable](slice []T, outerKey func(T) K1, innerKey func(T) K2) map[K1]map[K2][]T {
+	outer := GroupBy(slice, func(element T) (K1, T) { return outerKey(element), element })
+	result := make(map[K1]map[K2][]T, len(outer))
+	for key, group := range outer {
+		result[key] = GroupBy(group, func(element T) (K2, T) { return innerKey(element), element })
 	}
 	return result
 }
 
+// GroupNode is one level of a GroupByPath tree. Inner nodes have Children; the nodes below the
+// last key selector hold the Items of their group.
+type GroupNode[K comparable, T any] struct {
+	Children map[K]*GroupNode[K, T]
+	Items    []T
+}
+
+// GroupByPath groups elements by any number of nested keys, one key selector per level.
+func GroupByPath[T any, K comparable](slice []T, keySelectors ...func(T) K) *GroupNode[K, T] {
+	if len(keySelectors) == 0 {
+		return &GroupNode[K, T]{Items: EmptyIfNil(slice)}
+	}
+	groups := GroupBy(slice, func(element T) (K, T) { return keySelectors[0](element), element })
+	node := &GroupNode[K, T]{Children: make(map[K]*GroupNode[K, T], len(groups))}
+	for key, group := range groups {
+		node.Children[key] = GroupByPath(group, keySelectors[1:]...)
+	}
+	return node
+}
+
 func ChunkedBy[T any](slice []T, groupingFn func(T, T) bool) [][]T {
 	if len(slice) == 0 {
 		return [][]T{} // return an empty slice, not nil
@@ -63,6 +225,133 @@ func ChunkedBy[T any](slice []T, groupingFn func(T, T) bool) [][]T {
 	return result
 }
 
+// ChunkedByView is ChunkedBy without the copies: every chunk is a sub-slice of slice, capped so
+// that appending to it reallocates instead of overwriting the next chunk. Writes to a chunk's
+// elements are visible in slice. slices.Chunk is the view counterpart of fixed-size chunking.
+func ChunkedByView[T any](slice []T, groupingFn func(T, T) bool) [][]T {
+	result := make([][]T, 0)
+	start := 0
+	for i := 1; i <= len(slice); i++ {
+		if i == len(slice) || !groupingFn(slice[i-1], slice[i]) {
+			result = append(result, slice[start:i:i])
+			start = i
+		}
+	}
+	return result
+}
+
+// RunLengthEncode collapses every run of equal consecutive elements into its value and length.
+func RunLengthEncode[T comparable](slice []T) []Pair[T, int] {
+	runs := ChunkedByView(slice, func(previous, next T) bool { return previous == next })
+	return Map(runs, func(run []T) Pair[T, int] { return Pair[T, int]{First: run[0], Second: len(run)} })
+}
+
+func RunLengthDecode[T any](runs []Pair[T, int]) []T {
+	total := 0
+	for _, run := range runs {
+		if run.Second < 0 {
+			panic("RunLengthDecode: run lengths must not be negative")
+		}
+		total += run.Second
+	}
+	result := make([]T, 0, total)
+	for _, run := range runs {
+		for range run.Second {
+			result = append(result, run.First)
+		}
+	}
+	return result
+}
+
+// ChunkedByWeight packs consecutive elements into chunks whose total weight does not exceed
+// maxWeight. An element heavier than maxWeight on its own gets a chunk to itself.
+func ChunkedByWeight[T any](slice []T, maxWeight int, weightFn func(T) int) [][]T {
+	if maxWeight <= 0 {
+		panic("ChunkedByWeight: maxWeight must be positive")
+	}
+	result := make([][]T, 0)
+	start, weight := 0, 0
+	for i, element := range slice {
+		elementWeight := weightFn(element)
+		if i > start && weight+elementWeight > maxWeight {
+			result = append(result, slices.Clone(slice[start:i]))
+			start, weight = i, 0
+		}
+		weight += elementWeight
+	}
+	if start < len(slice) {
+		result = append(result, slices.Clone(slice[start:]))
+	}
+	return result
+}
+
+// SplitBy splits slice around the elements matching isDelimiter, dropping the delimiters.
+// Like strings.Split, adjacent, leading or trailing delimiters produce empty segments, and an
+// empty slice produces a single empty segment.
+func SplitBy[T any](slice []T, isDelimiter func(T) bool) [][]T {
+	if len(slice) == 0 {
+		return [][]T{{}}
+	}
+	result := make([][]T, 0)
+	start := 0
+	for i, element := range slice {
+		if isDelimiter(element) {
+			result = append(result, slices.Clone(slice[start:i]))
+			start = i + 1
+		}
+	}
+	return append(result, slices.Clone(slice[start:]))
+}
+
+// SplitAt cuts slice before each of the given ascending indices, returning len(indices)+1 segments.
+func SplitAt[T any](slice []T, indices ...int) [][]T {
+	result := make([][]T, 0, len(indices)+1)
+	start := 0
+	for _, index := range indices {
+		if index < start || index > len(slice) {
+			panic("SplitAt: indices must be ascending and within the slice")
+		}
+		result = append(result, slices.Clone(slice[start:index]))
+		start = index
+	}
+	return append(result, slices.Clone(slice[start:]))
+}
+
+// SubSlice returns slice[from:to] with Python-style indexing: negative indices count from the
+// end, and out-of-range indices are clamped, so it never panics. The result shares memory with
+// slice but has its capacity capped, so appending to it does not overwrite slice.
+func SubSlice[T any](slice []T, from, to int) []T {
+	from, to = clampIndex(from, len(slice)), clampIndex(to, len(slice))
+	if from >= to {
+		return []T{}
+	}
+	return slice[from:to:to]
+}
+
+func clampIndex(index, length int) int {
+	if index < 0 {
+		index += length
+	}
+	return min(max(index, 0), length)
+}
+
+// Slice returns the elements at the given indices, in that order; negative indices count from
+// the end. It panics if an index is out of range.
+func Slice[T any](slice []T, indices ...int) []T {
+	result := make([]T, len(indices))
+	for i, index := range indices {
+		position := index
+		if position < 0 {
+			position += len(slice)
+		}
+		if position < 0 || position >= len(slice) {
+			panic(fmt.Sprintf("Slice: index %d out of range for length %d", index, len(slice)))
+		}
+		result[i] = slice[position]
+	}
+	return result
+}
+
 func Distinct[T comparable](slice []T) []T {
 	if len(slice) == 0 {
 		return []T{}
@@ -78,6 +367,71 @@ func Distinct[T comparable](slice []T) []T {
 	return result
 }
 
+func DistinctInPlace[T comparable](slice []T) []T {
+	seen := make(map[T]struct{}, len(slice))
+	n := 0
+	for _, element := range slice {
+		if _, exists := seen[element]; !exists {
+			seen[element] = struct{}{}
+			slice[n] = element
+			n++
+		}
+	}
+	clear(slice[n:])
+	return EmptyIfNil(slice[:n])
+}
+
+func Fill[T any](slice []T, value T) {
+	for i := range slice {
+		slice[i] = value
+	}
+}
+
+// Clone2D copies every row as well as the outer slice, so neither can be modified through the
+// other. Nil rows stay nil.
+func Clone2D[T any](matrix [][]T) [][]T {
+	result := make([][]T, len(matrix))
+	for i, row := range matrix {
+		result[i] = slices.Clone(row)
+	}
+	return result
+}
+
+// CloneWith copies slice using cloneElem for every element, for elements that own memory of
+// their own such as slices, maps or pointers.
+func CloneWith[T any](slice []T, cloneElem func(T) T) []T {
+	return Map(slice, cloneElem)
+}
+
+// RotateLeft returns a copy of slice with its first n elements moved to the end. A negative n
+// rotates right, and n may exceed the length.
+func RotateLeft[T any](slice []T, n int) []T {
+	return RotateLeftInPlace(slices.Clone(EmptyIfNil(slice)), n)
+}
+
+func RotateRight[T any](slice []T, n int) []T {
+	return RotateLeft(slice, -n)
+}
+
+func RotateLeftInPlace[T any](slice []T, n int) []T {
+	if len(slice) == 0 {
+		return EmptyIfNil(slice)
+	}
+	n = ((n % len(slice)) + len(slice)) % len(slice)
+	slices.Reverse(slice[:n])
+	slices.Reverse(slice[n:])
+	slices.Reverse(slice)
+	return slice
+}
+
+func RotateRightInPlace[T any](slice []T, n int) []T {
+	return RotateLeftInPlace(slice, -n)
+}
+
+func SwapElements[T any](slice []T, i, j int) {
+	slice[i], slice[j] = slice[j], slice[i]
+}
+
 func DistinctBy[T any, K comparable](slice []T, keySelector func(T) K) []T {
 	if len(slice) == 0 {
 		return []T{}
@@ -112,6 +466,52 @@ func DropWhile[T any](slice []T, predicate func(T) bool) []T {
 	return []T{}
 }
 
+func DropUntil[T any](slice []T, predicate func(T) bool) []T {
+	for i, element := range slice {
+		if predicate(element) {
+			return slice[i:]
+		}
+	}
+	return []T{}
+}
+
+func EncodeChunks[T any](slice []T, chunkSize int, encode func([]T) ([]byte, error)) ([][]byte, error) {
+	if chunkSize <= 0 {
+		panic("EncodeChunks: chunkSize must be positive")
+	}
+	result := make([][]byte, 0, (len(slice)+chunkSize-1)/chunkSize)
+	index := 0
+	for chunk := range slices.Chunk(slice, chunkSize) {
+		encoded, err := encode(chunk)
+		if err != nil {
+			start := index * chunkSize
+			return nil, fmt.Errorf("encode chunk %d (elements %d-%d): %w", index, start, start+len(chunk)-1, err)
+		}
+		result = append(result, encoded)
+		index++
+	}
+	return result, nil
+}
+
+func DecodeChunks[T any](chunks [][]byte, decode func([]byte) ([]T, error)) ([]T, error) {
+	result := make([]T, 0, len(chunks))
+	for i, chunk := range chunks {
+		decoded, err := decode(chunk)
+		if err != nil {
+			return nil, fmt.Errorf("decode chunk %d (%d bytes, after %d elements): %w", i, len(chunk), len(result), err)
+		}
+		result = append(result, decoded...)
+	}
+	return result, nil
+}
+
+func EmptyIfNil[T any](slice []T) []T {
+	if slice == nil {
+		return []T{}
+	}
+	return slice
+}
+
 func Filter[T any](slice []T, predicate func(T) bool) []T {
 	result := make([]T, 0, len(slice))
 	for _, element := range slice {
@@ -122,6 +522,54 @@ func Filter[T any](slice []T, predicate func(T) bool) []T {
 	return result
 }
 
+func FilterTo[T any](slice []T, destination []T, predicate func(T) bool) []T {
+	for _, element := range slice {
+		if predicate(element) {
+			destination = append(destination, element)
+		}
+	}
+	return EmptyIfNil(destination)
+}
+
+func FilterInPlace[T any](slice []T, predicate func(T) bool) []T {
+	n := 0
+	for _, element := range slice {
+		if predicate(element) {
+			slice[n] = element
+			n++
+		}
+	}
+	clear(slice[n:])
+	return EmptyIfNil(slice[:n])
+}
+
+// FilterIsType keeps the elements whose dynamic type is T (or implements T, for interface
+// types), converted to T.
+func FilterIsType[T any](slice []any) []T {
+	result := make([]T, 0)
+	for _, element := range slice {
+		if typed, ok := element.(T); ok {
+			result = append(result, typed)
+		}
+	}
+	return result
+}
+
+var ErrUnexpectedType = errors.New("unexpected type")
+
+// MapCast converts every element to T, failing on the first one that is not a T.
+func MapCast[T any](slice []any) ([]T, error) {
+	result := make([]T, len(slice))
+	for i, element := range slice {
+		typed, ok := element.(T)
+		if !ok {
+			return nil, fmt.Errorf("item %d: %w %T, expected %v", i, ErrUnexpectedType, element, reflect.TypeFor[T]())
+		}
+		result[i] = typed
+	}
+	return result, nil
+}
+
 func FilterIndexed[T any](slice []T, predicate func(int, T) bool) []T {
 	result := make([]T, 0, len(slice))
 	for i, element := range slice {
@@ -132,6 +580,28 @@ func FilterIndexed[T any](slice []T, predicate func(int, T) bool) []T {
 	return result
 }
 
+func FindWithContext[T any](slice []T, predicate func(T) bool, before, after int) ([]T, int, bool) {
+	if before < 0 || after < 0 {
+		panic("FindWithContext: before and after must not be negative")
+	}
+	for i, element := range slice {
+		if predicate(element) {
+			start := max(0, i-before)
+			end := min(len(slice), i+after+1)
+			return slice[start:end], i, true
+		}
+	}
+	return []T{}, -1, false
+}
+
+func FirstValue[M ~map[K][]V, K comparable, V any](m M, key K) (V, bool) {
+	if values := m[key]; len(values) > 0 {
+		return values[0], true
+	}
+	var zero V
+	return zero, false
+}
+
 func FlatMap[T1, T2 any](slice []T1, transform func(T1) []T2) []T2 {
 	result := make([]T2, 0, len(slice))
 	for _, element := range slice {
@@ -164,6 +634,18 @@ func FoldIndexed[T, R any](slice []T, initial R, combine func(int, R, T) R) R {
 	return acc
 }
 
+func FoldWhile[T, R any](slice []T, initial R, combine func(R, T) (R, bool)) R {
+	acc := initial
+	for _, element := range slice {
+		next, proceed := combine(acc, element)
+		acc = next
+		if !proceed {
+			break
+		}
+	}
+	return acc
+}
+
 func FoldMapEntries[M ~map[K]V, K comparable, V, R any](
 	m M,
 	initial R,
@@ -176,6 +658,84 @@ func FoldMapEntries[M ~map[K]V, K comparable, V, R any](
 	return acc
 }
 
+func AnyEntry[M ~map[K]V, K comparable, V any](m M, predicate func(K, V) bool) bool {
+	for key, value := range m {
+		if predicate(key, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func AllEntries[M ~map[K]V, K comparable, V any](m M, predicate func(K, V) bool) bool {
+	return !AnyEntry(m, func(key K, value V) bool { return !predicate(key, value) })
+}
+
+func NoneEntry[M ~map[K]V, K comparable, V any](m M, predicate func(K, V) bool) bool {
+	return !AnyEntry(m, predicate)
+}
+
+func CountEntries[M ~map[K]V, K comparable, V any](m M, predicate func(K, V) bool) int {
+	count := 0
+	for key, value := range m {
+		if predicate(key, value) {
+			count++
+		}
+	}
+	return count
+}
+
+// FirstEntry returns a matching entry. Map iteration order is unspecified, so when several
+// entries match, which one is returned may differ between calls.
+func FirstEntry[M ~map[K]V, K comparable, V any](m M, predicate func(K, V) bool) (Pair[K, V], bool) {
+	for key, value := range m {
+		if predicate(key, value) {
+			return Pair[K, V]{First: key, Second: value}, true
+		}
+	}
+	return Pair[K, V]{}, false
+}
+
+// MaxByEntry returns the entry whose selected value is largest. Among entries with equal
+// selected values, which one is returned is unspecified.
+func MaxByEntry[M ~map[K]V, K comparable, V any, R cmp.Ordered](m M, selector func(K, V) R) (Pair[K, V], bool) {
+	return bestEntry(m, selector, 1)
+}
+
+func MinByEntry[M ~map[K]V, K comparable, V any, R cmp.Ordered](m M, selector func(K, V) R) (Pair[K, V], bool) {
+	return bestEntry(m, selector, -1)
+}
+
+func bestEntry[M ~map[K]V, K comparable, V any, R cmp.Ordered](m M, selector func(K, V) R, sign int) (Pair[K, V], bool) {
+	var best Pair[K, V]
+	var bestSelected R
+	found := false
+	for key, value := range m {
+		if selected := selector(key, value); !found || cmp.Compare(selected, bestSelected)*sign > 0 {
+			best, bestSelected, found = Pair[K, V]{First: key, Second: value}, selected, true
+		}
+	}
+	return best, found
+}
+
+func Intersect[T comparable](first []T, second []T) []T {
+	if len(first) == 0 || len(second) == 0 {
+		return []T{}
+	}
+	retained := make(map[T]struct{}, len(second))
+	for _, element := range second {
+		retained[element] = struct{}{}
+	}
+	result := make([]T, 0, min(len(first), len(retained)))
+	for _, element := range first {
+		if _, exists := retained[element]; exists {
+			delete(retained, element)
+			result = append(result, element)
+		}
+	}
+	return result
+}
+
 func Items[M ~map[K]V, K comparable, V any](m M) []Pair[K, V] {
 	if len(m) == 0 {
 		return []Pair[K, V]{}
@@ -187,6 +747,32 @@ func Items[M ~map[K]V, K comparable, V any](m M) []Pair[K, V] {
 	return pairs
 }
 
+// SortedItems returns the entries of m ordered by compare.
+func SortedItems[M ~map[K]V, K comparable, V any](m M, compare func(Pair[K, V], Pair[K, V]) int) []Pair[K, V] {
+	items := Items(m)
+	slices.SortFunc(items, compare)
+	return items
+}
+
+func KeysSorted[M ~map[K]V, K cmp.Ordered, V any](m M) []K {
+	keys := make([]K, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// ValuesSortedByKey returns the values of m in ascending order of their keys.
+func ValuesSortedByKey[M ~map[K]V, K cmp.Ordered, V any](m M) []V {
+	keys := KeysSorted(m)
+	values := make([]V, len(keys))
+	for i, key := range keys {
+		values[i] = m[key]
+	}
+	return values
+}
+
 func Map[T, R any](slice []T, transform func(T) R) []R {
 	if len(slice) == 0 {
 		return []R{}
@@ -198,6 +784,20 @@ func Map[T, R any](slice []T, transform func(T) R) []R {
 	return result
 }
 
+func MapTo[T, R any](slice []T, destination []R, transform func(T) R) []R {
+	for _, element := range slice {
+		destination = append(destination, transform(element))
+	}
+	return EmptyIfNil(destination)
+}
+
+func MapInPlace[T any](slice []T, transform func(T) T) []T {
+	for i, element := range slice {
+		slice[i] = transform(element)
+	}
+	return EmptyIfNil(slice)
+}
+
 func MapIndexed[T, R any](slice []T, transform func(int, T) R) []R {
 	if len(slice) == 0 {
 		return []R{}
@@ -209,6 +809,11 @@ func MapIndexed[T, R any](slice []T, transform func(int, T) R) []R {
 	return result
 }
 
+func OnEach[T any](slice []T, action func(T)) []T {
+	ForEach(slice, action)
+	return EmptyIfNil(slice)
+}
+
 func Partition[T any](slice []T, predicate func(T) bool) ([]T, []T) {
 	matching := make([]T, 0, len(slice))
 	others := make([]T, 0, len(slice))
@@ -222,6 +827,101 @@ func Partition[T any](slice []T, predicate func(T) bool) ([]T, []T) {
 	return matching, others
 }
 
+// PartitionN splits slice into n buckets, putting each element into bucket bucketFn(element).
+// It panics if bucketFn returns an index outside [0, n).
+func PartitionN[T any](slice []T, n int, bucketFn func(T) int) [][]T {
+	if n <= 0 {
+		panic("PartitionN: n must be positive")
+	}
+	buckets := make([][]T, n)
+	for i := range buckets {
+		buckets[i] = []T{}
+	}
+	for _, element := range slice {
+		bucket := bucketFn(element)
+		if bucket < 0 || bucket >= n {
+			panic(fmt.Sprintf("PartitionN: bucket index %d out of range [0, %d)", bucket, n))
+		}
+		buckets[bucket] = append(buckets[bucket], element)
+	}
+	return buckets
+}
+
+// PartitionBy splits slice into one group per distinct key, ordered by each key's first
+// appearance. Unlike GroupBy the result keeps a deterministic order.
+func PartitionBy[T any, K comparable](slice []T, keyFn func(T) K) [][]T {
+	positions := make(map[K]int)
+	groups := make([][]T, 0)
+	for _, element := range slice {
+		key := keyFn(element)
+		position, exists := positions[key]
+		if !exists {
+			position = len(groups)
+			positions[key] = position
+			groups = append(groups, []T{})
+		}
+		groups[position] = append(groups[position], element)
+	}
+	return groups
+}
+
+// BinarySearchBy searches a slice sorted by keyFn for key, returning the position where key is
+// or would be inserted, and whether it was found.
+func BinarySearchBy[T any, K cmp.Ordered](slice []T, keyFn func(T) K, key K) (int, bool) {
+	return slices.BinarySearchFunc(slice, key, func(element T, target K) int {
+		return cmp.Compare(keyFn(element), target)
+	})
+}
+
+// InsertSorted inserts value into the sorted slice at the position that keeps it sorted.
+func InsertSorted[T cmp.Ordered](slice []T, value T) []T {
+	index, _ := slices.BinarySearch(slice, value)
+	return slices.Insert(slice, index, value)
+}
+
+func Plus[T any](slice []T, elements ...T) []T {
+	result := make([]T, 0, len(slice)+len(elements))
+	result = append(result, slice...)
+	return append(result, elements...)
+}
+
+func MergeValues[M ~map[K][]V, K comparable, V any](maps ...M) M {
+	result := make(M)
+	for _, m := range maps {
+		for key, values := range m {
+			result[key] = append(result[key], values...)
+		}
+	}
+	return result
+}
+
+func Minus[T comparable](slice []T, other []T) []T {
+	if len(other) == 0 {
+		return Plus(slice)
+	}
+	excluded := make(map[T]struct{}, len(other))
+	for _, element := range other {
+		excluded[element] = struct{}{}
+	}
+	return Filter(slice, func(element T) bool {
+		_, exists := excluded[element]
+		return !exists
+	})
+}
+
+func MinusElement[T comparable](slice []T, element T) []T {
+	result := make([]T, 0, len(slice))
+	removed := false
+	for _, current := range slice {
+		if !removed && current == element {
+			removed = true
+			continue
+		}
+		result = append(result, current)
+	}
+	return result
+}
+
 func Reduce[T any](slice []T, combine func(T, T) T) T {
 	if len(slice) == 0 {
 		panic("Reduce called on empty slice")
@@ -244,6 +944,102 @@ func ReduceIndexed[T any](slice []T, combine func(int, T, T) T) T {
 	})
 }
 
+func ReduceWhile[T any](slice []T, combine func(T, T) (T, bool)) T {
+	if len(slice) == 0 {
+		panic("ReduceWhile called on empty slice")
+	}
+	return FoldWhile(slice[1:], slice[0], combine)
+}
+
+// The OK-suffixed functions report false instead of panicking when there is no value to return.
+
+func ReduceOK[T any](slice []T, combine func(T, T) T) (T, bool) {
+	if len(slice) == 0 {
+		var zero T
+		return zero, false
+	}
+	return Reduce(slice, combine), true
+}
+
+func ReduceOrDefault[T any](slice []T, combine func(T, T) T, fallback T) T {
+	if result, ok := ReduceOK(slice, combine); ok {
+		return result
+	}
+	return fallback
+}
+
+func ReduceIndexedOK[T any](slice []T, combine func(int, T, T) T) (T, bool) {
+	if len(slice) == 0 {
+		var zero T
+		return zero, false
+	}
+	return ReduceIndexed(slice, combine), true
+}
+
+func FirstOK[T any](slice []T) (T, bool) {
+	return ElementAtOK(slice, 0)
+}
+
+func LastOK[T any](slice []T) (T, bool) {
+	return ElementAtOK(slice, len(slice)-1)
+}
+
+// SingleOK reports true only when the slice has exactly one element.
+func SingleOK[T any](slice []T) (T, bool) {
+	if len(slice) != 1 {
+		var zero T
+		return zero, false
+	}
+	return slice[0], true
+}
+
+func ElementAtOK[T any](slice []T, index int) (T, bool) {
+	if index < 0 || index >= len(slice) {
+		var zero T
+		return zero, false
+	}
+	return slice[index], true
+}
+
+func ElementAtOrDefault[T any](slice []T, index int, fallback T) T {
+	if element, ok := ElementAtOK(slice, index); ok {
+		return element
+	}
+	return fallback
+}
+
+// ElementAtOrElse calls fallback with the index only when index is out of range.
+func ElementAtOrElse[T any](slice []T, index int, fallback func(int) T) T {
+	if element, ok := ElementAtOK(slice, index); ok {
+		return element
+	}
+	return fallback(index)
+}
+
+// GetOrDefault returns the value stored under key, or fallback without storing it.
+func GetOrDefault[M ~map[K]V, K comparable, V any](m M, key K, fallback V) V {
+	if value, exists := m[key]; exists {
+		return value
+	}
+	return fallback
+}
+
+func MaxOK[T cmp.Ordered](slice []T) (T, bool) {
+	if len(slice) == 0 {
+		var zero T
+		return zero, false
+	}
+	return slices.Max(slice), true
+}
+
+func MinOK[T cmp.Ordered](slice []T) (T, bool) {
+	if len(slice) == 0 {
+		var zero T
+		return zero, false
+	}
+	return slices.Min(slice), true
+}
+
 func TakeLastWhile[T any](slice []T, predicate func(T) bool) []T {
 	if len(slice) == 0 {
 		return []T{}
@@ -270,6 +1066,18 @@ func TakeWhile[T any](slice []T, predicate func(T) bool) []T {
 	return slice[:i]
 }
 
+func TakeUntil[T any](slice []T, predicate func(T) bool) []T {
+	if len(slice) == 0 {
+		return []T{}
+	}
+	for i, element := range slice {
+		if predicate(element) {
+			return slice[:i+1]
+		}
+	}
+	return slice
+}
+
 func MapEntries[M ~map[K]V, K comparable, V any](
 	m M,
 	transform func(K, V) (K, V),
@@ -282,6 +1090,141 @@ func MapEntries[M ~map[K]V, K comparable, V any](
 	return result
 }
 
+// MapKeysStrict transforms the keys of m, reporting every pair of keys that transform to the
+// same new key as an ErrDuplicateKey instead of letting one of them silently win.
+func MapKeysStrict[M ~map[K]V, K comparable, V any](m M, transform func(K) K) (M, error) {
+	result := make(M, len(m))
+	originals := make(map[K]K, len(m))
+	errs := make([]error, 0)
+	for key, value := range m {
+		newKey := transform(key)
+		if original, exists := originals[newKey]; exists {
+			errs = append(errs, fmt.Errorf("%w %v (from %v and %v)", ErrDuplicateKey, newKey, original, key))
+			continue
+		}
+		originals[newKey] = key
+		result[newKey] = value
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return result, nil
+}
+
+// ElementsMatch reports whether a and b contain the same elements the same number of times,
+// in any order.
+func ElementsMatch[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	counts := make(map[T]int, len(a))
+	for _, element := range a {
+		counts[element]++
+	}
+	for _, element := range b {
+		if counts[element] == 0 {
+			return false
+		}
+		counts[element]--
+	}
+	return true
+}
+
+func HasPrefix[T comparable](slice, prefix []T) bool {
+	return len(prefix) <= len(slice) && slices.Equal(slice[:len(prefix)], prefix)
+}
+
+func HasSuffix[T comparable](slice, suffix []T) bool {
+	return len(suffix) <= len(slice) && slices.Equal(slice[len(slice)-len(suffix):], suffix)
+}
+
+// ContainsSubslice reports whether sub occurs contiguously in slice, using Knuth-Morris-Pratt
+// so the search is O(len(slice)+len(sub)). An empty sub is contained in every slice.
+func ContainsSubslice[T comparable](slice, sub []T) bool {
+	if len(sub) == 0 {
+		return true
+	}
+	// fallback[i] is the length of the longest proper prefix of sub[:i+1] that is also its suffix
+	fallback := make([]int, len(sub))
+	for i, matched := 1, 0; i < len(sub); i++ {
+		for matched > 0 && sub[i] != sub[matched] {
+			matched = fallback[matched-1]
+		}
+		if sub[i] == sub[matched] {
+			matched++
+		}
+		fallback[i] = matched
+	}
+	matched := 0
+	for _, element := range slice {
+		for matched > 0 && element != sub[matched] {
+			matched = fallback[matched-1]
+		}
+		if element == sub[matched] {
+			matched++
+		}
+		if matched == len(sub) {
+			return true
+		}
+	}
+	return false
+}
+
+func Union[T comparable](first []T, second []T) []T {
+	seen := make(map[T]struct{}, len(first)+len(second))
+	result := make([]T, 0, len(first)+len(second))
+	for _, slice := range [][]T{first, second} {
+		for _, element := range slice {
+			if _, exists := seen[element]; !exists {
+				seen[element] = struct{}{}
+				result = append(result, element)
+			}
+		}
+	}
+	return result
+}
+
+func PrefixKeys[M ~map[string]V, V any](m M, prefix string) M {
+	result := make(M, len(m))
+	for key, value := range m {
+		result[prefix+key] = value
+	}
+	return result
+}
+
+func FilterKeysByPrefix[M ~map[string]V, V any](m M, prefix string) M {
+	result := make(M)
+	for key, value := range m {
+		if strings.HasPrefix(key, prefix) {
+			result[key] = value
+		}
+	}
+	return result
+}
+
+func StripKeyPrefix[M ~map[string]V, V any](m M, prefix string) M {
+	result := make(M)
+	for key, value := range m {
+		if stripped, found := strings.CutPrefix(key, prefix); found {
+			result[stripped] = value
+		}
+	}
+	return result
+}
+
+func PartitionKeysByPrefix[M ~map[string]V, V any](m M, separator string) map[string]M {
+	result := make(map[string]M)
+	for key, value := range m {
+		namespace, rest, found := strings.Cut(key, separator)
+		if !found {
+			namespace, rest = "", key
+		}
+		group, _ := GetOrPut(result, namespace, func(string) M { return make(M) })
+		group[rest] = value
+	}
+	return result
+}
+
 func Unzip[T1, T2 any](pairs []Pair[T1, T2]) ([]T1, []T2) {
 	firsts := make([]T1, 0, len(pairs))
 	seconds := make([]T2, 0, len(pairs))
@@ -292,6 +1235,62 @@ func Unzip[T1, T2 any](pairs []Pair[T1, T2]) ([]T1, []T2) {
 	return firsts, seconds
 }
 
+// Project2 extracts two columns in a single pass over slice.
+func Project2[T, A, B any](slice []T, first func(T) A, second func(T) B) ([]A, []B) {
+	as := make([]A, len(slice))
+	bs := make([]B, len(slice))
+	for i, element := range slice {
+		as[i] = first(element)
+		bs[i] = second(element)
+	}
+	return as, bs
+}
+
+// Project3 extracts three columns in a single pass over slice.
+func Project3[T, A, B, C any](slice []T, first func(T) A, second func(T) B, third func(T) C) ([]A, []B, []C) {
+	as := make([]A, len(slice))
+	bs := make([]B, len(slice))
+	cs := make([]C, len(slice))
+	for i, element := range slice {
+		as[i] = first(element)
+		bs[i] = second(element)
+		cs[i] = third(element)
+	}
+	return as, bs, cs
+}
+
+func Intersperse[T any](slice []T, separator T) []T {
+	if len(slice) == 0 {
+		return []T{}
+	}
+	result := make([]T, 0, 2*len(slice)-1)
+	for i, element := range slice {
+		if i > 0 {
+			result = append(result, separator)
+		}
+		result = append(result, element)
+	}
+	return result
+}
+
+// Interleave takes one element from each slice in turn; once a slice runs out the rest continue without it.
+func Interleave[T any](sources ...[]T) []T {
+	total, longest := 0, 0
+	for _, slice := range sources {
+		total += len(slice)
+		longest = max(longest, len(slice))
+	}
+	result := make([]T, 0, total)
+	for i := range longest {
+		for _, slice := range sources {
+			if i < len(slice) {
+				result = append(result, slice[i])
+			}
+		}
+	}
+	return result
+}
+
 func Zip[T1, T2 any](first []T1, second []T2) []Pair[T1, T2] {
 	minLen := min(len(first), len(second))
 	if minLen == 0 {
@@ -326,3 +1325,20 @@ func Windowed[T any](slice []T, size, step int) [][]T {
 	}
 	return result
 }
+
+// WindowedView is Windowed without the copies: windows are capped sub-slices of slice, so
+// overlapping windows share elements and writes to them are visible in slice.
+func WindowedView[T any](slice []T, size, step int) [][]T {
+	if len(slice) == 0 {
+		return [][]T{}
+	}
+	if size <= 0 || step <= 0 {
+		panic("WindowedView: size and step must be positive")
+	}
+	result := make([][]T, 0, (len(slice)+step-1)/step)
+	for i := 0; i < len(slice); i += step {
+		end := min(i+size, len(slice))
+		result = append(result, slice[i:end:end])
+	}
+	return result
+}
@@ -1,8 +1,14 @@
 package godelin
 
 import (
+	"cmp"
+	"errors"
 	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
 	"reflect"
+	"slices"
 	"sort"
 	"strings"
 	"testing"
@@ -80,6 +86,28 @@ func TestAny(t *testing.T) {
 	}
 }
 
+func TestForEach(t *testing.T) {
+	var visited []string
+	ForEach([]string{"a", "b", "c"}, func(s string) {
+		visited = append(visited, s)
+	})
+	expected := []string{"a", "b", "c"}
+	if !reflect.DeepEqual(visited, expected) {
+		t.Errorf("ForEach() visited %v, expected %v", visited, expected)
+	}
+}
+
+func TestForEachIndexed(t *testing.T) {
+	var visited []string
+	ForEachIndexed([]string{"a", "b"}, func(i int, s string) {
+		visited = append(visited, fmt.Sprintf("%d:%s", i, s))
+	})
+	expected := []string{"0:a", "1:b"}
+	if !reflect.DeepEqual(visited, expected) {
+		t.Errorf("ForEachIndexed() visited %v, expected %v", visited, expected)
+	}
+}
+
 func TestGetOrPut(t *testing.T) {
 	testCases := []struct {
 		name         string
@@ -87,6 +115,7 @@ func TestGetOrPut(t *testing.T) {
 		key          string
 		defaultValue func(string) int
 		expected     int
+		loaded       bool
 		finalMap     map[string]int
 	}{
 		{
@@ -97,6 +126,7 @@ func TestGetOrPut(t *testing.T) {
 				return 99
 			},
 			expected: 10,
+			loaded:   true,
 			finalMap: map[string]int{"a": 10, "b": 20},
 		},
 		{
@@ -126,9 +156,9 @@ func TestGetOrPut(t *testing.T) {
 
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			result := GetOrPut(testCase.initialMap, testCase.key, testCase.defaultValue)
-			if result != testCase.expected {
-				t.Errorf("GetOrPut() = %v, expected %v", result, testCase.expected)
+			result, loaded := GetOrPut(testCase.initialMap, testCase.key, testCase.defaultValue)
+			if result != testCase.expected || loaded != testCase.loaded {
+				t.Errorf("GetOrPut() = %v, %v, expected %v, %v", result, loaded, testCase.expected, testCase.loaded)
 			}
 			if !reflect.DeepEqual(testCase.initialMap, testCase.finalMap) {
 				t.Errorf("map after GetOrPut = %v, expected %v", testCase.initialMap, testCase.finalMap)
@@ -137,6 +167,21 @@ func TestGetOrPut(t *testing.T) {
 	}
 }
 
+func TestGetOrPutSafe(t *testing.T) {
+	type registry struct {
+		handlers map[string]int
+	}
+	var r registry
+	value, loaded := GetOrPutSafe(&r.handlers, "a", func(string) int { return 1 })
+	if value != 1 || loaded || !reflect.DeepEqual(r.handlers, map[string]int{"a": 1}) {
+		t.Errorf("GetOrPutSafe(nil) = %v, %v with map %v, expected 1, false", value, loaded, r.handlers)
+	}
+	value, loaded = GetOrPutSafe(&r.handlers, "a", func(string) int { return 2 })
+	if value != 1 || !loaded {
+		t.Errorf("GetOrPutSafe() = %v, %v, expected 1, true", value, loaded)
+	}
+}
+
 func TestFilter(t *testing.T) {
 	testCases := []struct {
 		name      string
@@ -225,6 +270,98 @@ func TestAssociate_EmptySlice(t *testing.T) {
 	}
 }
 
+func TestAssociate(t *testing.T) {
+	users := []string{"alice", "bob", "anna"}
+	result := Associate(users, func(name string) (string, int) {
+		return name[:1], len(name)
+	})
+	expected := map[string]int{"a": 4, "b": 3} // last one wins
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Associate() = %v, expected %v", result, expected)
+	}
+}
+
+func TestAssociateTo(t *testing.T) {
+	destination := map[string]int{"z": 26}
+	result := AssociateTo([]string{"a", "b"}, destination, func(s string) (string, int) {
+		return s, int(s[0] - 'a' + 1)
+	})
+	expected := map[string]int{"a": 1, "b": 2, "z": 26}
+	if !reflect.DeepEqual(result, expected) || !reflect.DeepEqual(destination, expected) {
+		t.Errorf("AssociateTo() = %v, destination = %v, expected %v", result, destination, expected)
+	}
+}
+
+func TestGroupByToAccumulatesAcrossBatches(t *testing.T) {
+	destination := map[bool][]int{}
+	isEven := func(n int) (bool, int) { return n%2 == 0, n }
+	GroupByTo([]int{1, 2, 3}, destination, isEven)
+	GroupByTo([]int{4, 5}, destination, isEven)
+	expected := map[bool][]int{
+		false: {1, 3, 5},
+		true:  {2, 4},
+	}
+	if !reflect.DeepEqual(destination, expected) {
+		t.Errorf("GroupByTo() = %v, expected %v", destination, expected)
+	}
+}
+
+func TestSplitBy(t *testing.T) {
+	isComma := func(s string) bool { return s == "," }
+	testCases := []struct {
+		name     string
+		slice    []string
+		expected [][]string
+	}{
+		{"separators", []string{"a", ",", "b", "c", ",", "d"}, [][]string{{"a"}, {"b", "c"}, {"d"}}},
+		{"leading and trailing", []string{",", "a", ","}, [][]string{{}, {"a"}, {}}},
+		{"adjacent", []string{"a", ",", ",", "b"}, [][]string{{"a"}, {}, {"b"}}},
+		{"no separators", []string{"a", "b"}, [][]string{{"a", "b"}}},
+		{"empty", []string{}, [][]string{{}}},
+		{"nil", nil, [][]string{{}}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := SplitBy(tc.slice, isComma); !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("SplitBy(%q) = %q, expected %q", tc.slice, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestSplitAt(t *testing.T) {
+	testCases := []struct {
+		name     string
+		indices  []int
+		expected [][]int
+	}{
+		{"inner indices", []int{1, 3}, [][]int{{1}, {2, 3}, {4, 5}}},
+		{"bounds", []int{0, 5}, [][]int{{}, {1, 2, 3, 4, 5}, {}}},
+		{"repeated index", []int{2, 2}, [][]int{{1, 2}, {}, {3, 4, 5}}},
+		{"no indices", nil, [][]int{{1, 2, 3, 4, 5}}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			input := []int{1, 2, 3, 4, 5}
+			got := SplitAt(input, tc.indices...)
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("SplitAt(%v) = %v, expected %v", tc.indices, got, tc.expected)
+			}
+			got[0] = append(got[0], 99)
+			if !reflect.DeepEqual(input, []int{1, 2, 3, 4, 5}) {
+				t.Errorf("appending to a segment modified the input: %v", input)
+			}
+		})
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected a panic but did not get one")
+		}
+	}()
+	SplitAt([]int{1, 2, 3}, 2, 1)
+}
+
 func TestChunkedBy(t *testing.T) {
 	input := []int{
 		10, 20, 30, 40,
@@ -247,6 +384,73 @@ func TestChunkedBy(t *testing.T) {
 	}
 }
 
+func TestRunLengthEncode(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []string
+		expected []Pair[string, int]
+	}{
+		{"empty", []string{}, []Pair[string, int]{}},
+		{"single", []string{"up"}, []Pair[string, int]{{"up", 1}}},
+		{"runs", []string{"up", "up", "up", "down", "up", "up"}, []Pair[string, int]{{"up", 3}, {"down", 1}, {"up", 2}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded := RunLengthEncode(tt.input)
+			if !reflect.DeepEqual(encoded, tt.expected) {
+				t.Errorf("RunLengthEncode() = %v, expected %v", encoded, tt.expected)
+			}
+			if decoded := RunLengthDecode(encoded); !reflect.DeepEqual(decoded, tt.input) {
+				t.Errorf("RunLengthDecode(RunLengthEncode()) = %v, expected %v", decoded, tt.input)
+			}
+		})
+	}
+}
+
+func TestRunLengthDecode(t *testing.T) {
+	if got, expected := RunLengthDecode([]Pair[int, int]{{7, 2}, {8, 0}, {9, 1}}), []int{7, 7, 9}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("RunLengthDecode() = %v, expected %v", got, expected)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected a panic but did not get one")
+		}
+	}()
+	RunLengthDecode([]Pair[int, int]{{1, -1}})
+}
+
+func TestChunkedByWeight(t *testing.T) {
+	length := func(s string) int { return len(s) }
+	tests := []struct {
+		name      string
+		input     []string
+		maxWeight int
+		expected  [][]string
+	}{
+		{"empty", []string{}, 5, [][]string{}},
+		{"packs up to the budget", []string{"ab", "cd", "e", "fgh", "ij"}, 5, [][]string{{"ab", "cd", "e"}, {"fgh", "ij"}}},
+		{"oversized element alone", []string{"a", "toolong", "b"}, 3, [][]string{{"a"}, {"toolong"}, {"b"}}},
+		{"oversized first element", []string{"toolong", "b"}, 3, [][]string{{"toolong"}, {"b"}}},
+		{"everything fits", []string{"a", "b"}, 10, [][]string{{"a", "b"}}},
+		{"weightless elements", []string{"", "", "abc", ""}, 3, [][]string{{"", "", "abc", ""}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ChunkedByWeight(tt.input, tt.maxWeight, length); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("ChunkedByWeight() = %q, expected %q", got, tt.expected)
+			}
+		})
+	}
+
+	input := []string{"ab", "cd"}
+	chunks := ChunkedByWeight(input, 2, length)
+	chunks[0] = append(chunks[0], "x")
+	if input[1] != "cd" {
+		t.Errorf("ChunkedByWeight() chunks share capacity with the input")
+	}
+}
+
 func TestDistinct(t *testing.T) {
 	type args struct {
 		s []int
@@ -273,6 +477,115 @@ func TestDistinct(t *testing.T) {
 	}
 }
 
+func TestDistinctInPlace(t *testing.T) {
+	input := []string{"b", "a", "b", "c", "a"}
+	backing := input
+	actual := DistinctInPlace(input)
+	expected := []string{"b", "a", "c"}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("DistinctInPlace() = %v, expected %v", actual, expected)
+	}
+	if &actual[0] != &backing[0] {
+		t.Errorf("DistinctInPlace() did not reuse the backing array")
+	}
+	if backing[3] != "" || backing[4] != "" {
+		t.Errorf("DistinctInPlace() left stale elements behind: %q", backing)
+	}
+}
+
+func TestFill(t *testing.T) {
+	backing := make([]string, 5)
+	Fill(backing[1:4], "x")
+	expected := []string{"", "x", "x", "x", ""}
+	if !reflect.DeepEqual(backing, expected) {
+		t.Errorf("Fill() = %q, expected %q", backing, expected)
+	}
+	Fill([]string(nil), "x")
+}
+
+func TestClone2D(t *testing.T) {
+	input := [][]int{{1, 2}, nil, {3}}
+	result := Clone2D(input)
+	if !reflect.DeepEqual(result, input) {
+		t.Errorf("Clone2D() = %v, expected %v", result, input)
+	}
+	result[0][0] = 100
+	result[2] = append(result[2], 4)
+	if input[0][0] != 1 || len(input[2]) != 1 {
+		t.Errorf("modifying Clone2D() changed the input: %v", input)
+	}
+	if got := Clone2D[int](nil); !reflect.DeepEqual(got, [][]int{}) {
+		t.Errorf("Clone2D(nil) = %v, expected []", got)
+	}
+}
+
+func TestCloneWith(t *testing.T) {
+	type user struct {
+		Name string
+		Tags []string
+	}
+	input := []user{{"ann", []string{"admin"}}, {"bob", nil}}
+	result := CloneWith(input, func(u user) user {
+		u.Tags = append([]string(nil), u.Tags...)
+		return u
+	})
+	if !reflect.DeepEqual(result, input) {
+		t.Errorf("CloneWith() = %v, expected %v", result, input)
+	}
+	result[0].Tags[0] = "guest"
+	if input[0].Tags[0] != "admin" {
+		t.Errorf("modifying CloneWith() changed the input: %v", input)
+	}
+}
+
+func TestRotate(t *testing.T) {
+	testCases := []struct {
+		name          string
+		n             int
+		expectedLeft  []int
+		expectedRight []int
+	}{
+		{"by one", 1, []int{2, 3, 4, 5, 1}, []int{5, 1, 2, 3, 4}},
+		{"by zero", 0, []int{1, 2, 3, 4, 5}, []int{1, 2, 3, 4, 5}},
+		{"by length", 5, []int{1, 2, 3, 4, 5}, []int{1, 2, 3, 4, 5}},
+		{"beyond length", 7, []int{3, 4, 5, 1, 2}, []int{4, 5, 1, 2, 3}},
+		{"negative", -2, []int{4, 5, 1, 2, 3}, []int{3, 4, 5, 1, 2}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			input := []int{1, 2, 3, 4, 5}
+			if got := RotateLeft(input, tc.n); !reflect.DeepEqual(got, tc.expectedLeft) {
+				t.Errorf("RotateLeft(%d) = %v, expected %v", tc.n, got, tc.expectedLeft)
+			}
+			if got := RotateRight(input, tc.n); !reflect.DeepEqual(got, tc.expectedRight) {
+				t.Errorf("RotateRight(%d) = %v, expected %v", tc.n, got, tc.expectedRight)
+			}
+			if !reflect.DeepEqual(input, []int{1, 2, 3, 4, 5}) {
+				t.Errorf("copying rotation modified its input: %v", input)
+			}
+			RotateLeftInPlace(input, tc.n)
+			if !reflect.DeepEqual(input, tc.expectedLeft) {
+				t.Errorf("RotateLeftInPlace(%d) = %v, expected %v", tc.n, input, tc.expectedLeft)
+			}
+			RotateRightInPlace(input, tc.n)
+			if !reflect.DeepEqual(input, []int{1, 2, 3, 4, 5}) {
+				t.Errorf("RotateRightInPlace(%d) did not undo RotateLeftInPlace: %v", tc.n, input)
+			}
+		})
+	}
+	if got := RotateLeft([]int(nil), 3); !reflect.DeepEqual(got, []int{}) {
+		t.Errorf("RotateLeft(nil) = %#v, expected []int{}", got)
+	}
+}
+
+func TestSwapElements(t *testing.T) {
+	slice := []string{"a", "b", "c"}
+	SwapElements(slice, 0, 2)
+	if expected := []string{"c", "b", "a"}; !reflect.DeepEqual(slice, expected) {
+		t.Errorf("SwapElements() = %v, expected %v", slice, expected)
+	}
+}
+
 func TestDistinctBy(t *testing.T) {
 	type args struct {
 		s  []string
@@ -399,6 +712,216 @@ func TestDropWhile(t *testing.T) {
 	}
 }
 
+func TestDropUntil(t *testing.T) {
+	testCases := []struct {
+		name      string
+		slice     []string
+		predicate func(string) bool
+		expected  []string
+	}{
+		{
+			name:      "keeps boundary element",
+			slice:     []string{"init", "ready", "ERROR disk", "retry", "ok"},
+			predicate: func(s string) bool { return strings.HasPrefix(s, "ERROR") },
+			expected:  []string{"ERROR disk", "retry", "ok"},
+		},
+		{
+			name:      "no element matches",
+			slice:     []string{"a", "b"},
+			predicate: func(s string) bool { return s == "z" },
+			expected:  []string{},
+		},
+		{
+			name:      "first element matches",
+			slice:     []string{"a", "b"},
+			predicate: func(s string) bool { return s == "a" },
+			expected:  []string{"a", "b"},
+		},
+		{
+			name:      "empty slice",
+			slice:     []string{},
+			predicate: func(s string) bool { return true },
+			expected:  []string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			actual := DropUntil(testCase.slice, testCase.predicate)
+			if !reflect.DeepEqual(actual, testCase.expected) {
+				t.Errorf("DropUntil() = %v, expected %v", actual, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestEncodeDecodeChunks(t *testing.T) {
+	encode := func(chunk []int) ([]byte, error) {
+		return []byte(fmt.Sprint(chunk)), nil
+	}
+	decode := func(data []byte) ([]int, error) {
+		fields := strings.Fields(strings.Trim(string(data), "[]"))
+		return Map(fields, func(field string) int {
+			var n int
+			fmt.Sscan(field, &n)
+			return n
+		}), nil
+	}
+	input := []int{1, 2, 3, 4, 5}
+
+	encoded, err := EncodeChunks(input, 2, encode)
+	if err != nil {
+		t.Fatalf("EncodeChunks() unexpected error: %v", err)
+	}
+	expectedEncoded := [][]byte{[]byte("[1 2]"), []byte("[3 4]"), []byte("[5]")}
+	if !reflect.DeepEqual(encoded, expectedEncoded) {
+		t.Errorf("EncodeChunks() = %q, expected %q", encoded, expectedEncoded)
+	}
+
+	decoded, err := DecodeChunks(encoded, decode)
+	if err != nil {
+		t.Fatalf("DecodeChunks() unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(decoded, input) {
+		t.Errorf("DecodeChunks() = %v, expected %v", decoded, input)
+	}
+}
+
+func TestEncodeChunksEmpty(t *testing.T) {
+	encoded, err := EncodeChunks([]int{}, 3, func([]int) ([]byte, error) { return nil, nil })
+	if err != nil || !reflect.DeepEqual(encoded, [][]byte{}) {
+		t.Errorf("EncodeChunks() = %v, %v, expected [], nil", encoded, err)
+	}
+}
+
+func TestEncodeDecodeChunksErrorContext(t *testing.T) {
+	failure := errors.New("boom")
+	_, err := EncodeChunks([]int{1, 2, 3, 4, 5}, 2, func(chunk []int) ([]byte, error) {
+		if chunk[0] == 3 {
+			return nil, failure
+		}
+		return []byte{}, nil
+	})
+	if !errors.Is(err, failure) || err.Error() != "encode chunk 1 (elements 2-3): boom" {
+		t.Errorf("EncodeChunks() error = %v", err)
+	}
+
+	_, err = DecodeChunks([][]byte{{1}, {2, 2}}, func(data []byte) ([]int, error) {
+		if len(data) == 2 {
+			return nil, failure
+		}
+		return []int{int(data[0])}, nil
+	})
+	if !errors.Is(err, failure) || err.Error() != "decode chunk 1 (2 bytes, after 1 elements): boom" {
+		t.Errorf("DecodeChunks() error = %v", err)
+	}
+}
+
+func TestEncodeChunksPanicsOnInvalidSize(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected a panic but did not get one")
+		}
+	}()
+	EncodeChunks([]int{1}, 0, func([]int) ([]byte, error) { return nil, nil })
+}
+
+func TestFilterTo(t *testing.T) {
+	isEven := func(x int) bool { return x%2 == 0 }
+	destination := make([]int, 0, 8)
+	destination = FilterTo([]int{1, 2, 3, 4}, destination, isEven)
+	destination = FilterTo([]int{5, 6}, destination, isEven)
+	expected := []int{2, 4, 6}
+	if !reflect.DeepEqual(destination, expected) {
+		t.Errorf("FilterTo() = %v, expected %v", destination, expected)
+	}
+	if cap(destination) != 8 {
+		t.Errorf("FilterTo() reallocated destination, cap = %d", cap(destination))
+	}
+}
+
+func TestFilterIsType(t *testing.T) {
+	payload := []any{1, "a", 2.5, 3, nil, "b", errors.New("boom")}
+	if got, expected := FilterIsType[int](payload), []int{1, 3}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("FilterIsType[int]() = %v, expected %v", got, expected)
+	}
+	if got, expected := FilterIsType[string](payload), []string{"a", "b"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("FilterIsType[string]() = %v, expected %v", got, expected)
+	}
+	if got := FilterIsType[error](payload); len(got) != 1 || got[0].Error() != "boom" {
+		t.Errorf("FilterIsType[error]() = %v, expected [boom]", got)
+	}
+	if got := FilterIsType[bool](payload); !reflect.DeepEqual(got, []bool{}) {
+		t.Errorf("FilterIsType[bool]() = %v, expected []", got)
+	}
+}
+
+func TestMapCast(t *testing.T) {
+	got, err := MapCast[float64]([]any{1.5, 2.0})
+	if expected := []float64{1.5, 2}; err != nil || !reflect.DeepEqual(got, expected) {
+		t.Errorf("MapCast() = %v, %v, expected %v, nil", got, err, expected)
+	}
+
+	got, err = MapCast[float64]([]any{1.5, "2", 3})
+	if got != nil || !errors.Is(err, ErrUnexpectedType) || err.Error() != "item 1: unexpected type string, expected float64" {
+		t.Errorf("MapCast() = %v, %v, expected nil and an item 1 type error", got, err)
+	}
+
+	if _, err := MapCast[fmt.Stringer]([]any{nil}); err == nil || err.Error() != "item 0: unexpected type <nil>, expected fmt.Stringer" {
+		t.Errorf("MapCast(nil) error = %v, expected an item 0 type error", err)
+	}
+}
+
+func TestFilterInPlace(t *testing.T) {
+	testCases := []struct {
+		name      string
+		slice     []int
+		predicate func(int) bool
+		expected  []int
+	}{
+		{
+			name:      "keep even numbers",
+			slice:     []int{1, 2, 3, 4, 5, 6},
+			predicate: func(x int) bool { return x%2 == 0 },
+			expected:  []int{2, 4, 6},
+		},
+		{
+			name:      "keep everything",
+			slice:     []int{1, 2},
+			predicate: func(x int) bool { return true },
+			expected:  []int{1, 2},
+		},
+		{
+			name:      "keep nothing",
+			slice:     []int{1, 2},
+			predicate: func(x int) bool { return false },
+			expected:  []int{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			backing := testCase.slice
+			actual := FilterInPlace(testCase.slice, testCase.predicate)
+			if !reflect.DeepEqual(actual, testCase.expected) {
+				t.Errorf("FilterInPlace() = %v, expected %v", actual, testCase.expected)
+			}
+			if cap(actual) != cap(backing) {
+				t.Errorf("FilterInPlace() did not reuse the backing array")
+			}
+		})
+	}
+}
+
+func TestFilterInPlaceClearsTail(t *testing.T) {
+	a, b, c := 1, 2, 3
+	input := []*int{&a, &b, &c}
+	FilterInPlace(input, func(p *int) bool { return *p == 2 })
+	if input[1] != nil || input[2] != nil {
+		t.Errorf("FilterInPlace() left references in the unused tail: %v", input)
+	}
+}
+
 func TestFilterIndexed(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -448,6 +971,100 @@ func TestFilterIndexed(t *testing.T) {
 	}
 }
 
+func TestFindWithContext(t *testing.T) {
+	events := []string{"boot", "connect", "auth", "panic", "restart", "connect"}
+	testCases := []struct {
+		name          string
+		predicate     func(string) bool
+		before        int
+		after         int
+		expected      []string
+		expectedIndex int
+		expectedFound bool
+	}{
+		{
+			name:          "context on both sides",
+			predicate:     func(s string) bool { return s == "panic" },
+			before:        2,
+			after:         1,
+			expected:      []string{"connect", "auth", "panic", "restart"},
+			expectedIndex: 3,
+			expectedFound: true,
+		},
+		{
+			name:          "context clamped at edges",
+			predicate:     func(s string) bool { return s == "connect" },
+			before:        5,
+			after:         10,
+			expected:      events,
+			expectedIndex: 1,
+			expectedFound: true,
+		},
+		{
+			name:          "no context",
+			predicate:     func(s string) bool { return s == "auth" },
+			before:        0,
+			after:         0,
+			expected:      []string{"auth"},
+			expectedIndex: 2,
+			expectedFound: true,
+		},
+		{
+			name:          "not found",
+			predicate:     func(s string) bool { return s == "shutdown" },
+			before:        1,
+			after:         1,
+			expected:      []string{},
+			expectedIndex: -1,
+			expectedFound: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			actual, index, found := FindWithContext(events, testCase.predicate, testCase.before, testCase.after)
+			if !reflect.DeepEqual(actual, testCase.expected) || index != testCase.expectedIndex || found != testCase.expectedFound {
+				t.Errorf(
+					"FindWithContext() = %v, %v, %v, expected %v, %v, %v",
+					actual, index, found, testCase.expected, testCase.expectedIndex, testCase.expectedFound,
+				)
+			}
+		})
+	}
+}
+
+func TestFirstValue(t *testing.T) {
+	query, _ := url.ParseQuery("tag=go&tag=kotlin&empty=")
+	testCases := []struct {
+		name          string
+		key           string
+		expected      string
+		expectedFound bool
+	}{
+		{name: "first of many", key: "tag", expected: "go", expectedFound: true},
+		{name: "empty value", key: "empty", expected: "", expectedFound: true},
+		{name: "missing key", key: "page", expected: "", expectedFound: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			actual, found := FirstValue(query, testCase.key)
+			if actual != testCase.expected || found != testCase.expectedFound {
+				t.Errorf("FirstValue() = %q, %v, expected %q, %v", actual, found, testCase.expected, testCase.expectedFound)
+			}
+		})
+	}
+}
+
+func TestFirstValueWithHeader(t *testing.T) {
+	header := http.Header{}
+	header.Add("Accept", "text/html")
+	header.Add("Accept", "application/json")
+	if actual, found := FirstValue(header, "Accept"); actual != "text/html" || !found {
+		t.Errorf("FirstValue() = %q, %v, expected %q, true", actual, found, "text/html")
+	}
+}
+
 func TestFlatMap(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -515,16 +1132,107 @@ func (w wrapped) String() string {
 	return fmt.Sprintf("Wrapped:'%s'", w.value)
 }
 
-func TestGroupByWithNewTypesForKeyAndValue(t *testing.T) {
-	input := []string{"a", "abc", "ab", "def", "abcd"}
-	want := map[float64][]*wrapped{
-		10.0: {&wrapped{"a"}},
-		20.0: {&wrapped{"ab"}},
-		30.0: {&wrapped{"abc"}, &wrapped{"def"}},
-		40.0: {&wrapped{"abcd"}},
+func TestGroupByTwoPass(t *testing.T) {
+	fruits := []string{"apple", "avocado", "banana", "blueberry", "cherry"}
+	byLetter := GroupByTwoPass(fruits, func(fruit string) (byte, string) { return fruit[0], fruit })
+	expectedByLetter := map[byte][]string{'a': {"apple", "avocado"}, 'b': {"banana", "blueberry"}, 'c': {"cherry"}}
+	if !reflect.DeepEqual(byLetter, expectedByLetter) {
+		t.Errorf("GroupByTwoPass() = %v, expected %v", byLetter, expectedByLetter)
+	}
+	got := GroupByTwoPass([]int{1, 2, 3, 4, 5}, func(n int) (bool, int) { return n%2 == 0, n })
+	odd := append(got[false], 7)
+	odd[0] = 0
+	expected := map[bool][]int{true: {2, 4}, false: {1, 3, 5}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("GroupByTwoPass() = %v after appending to one group, expected %v", got, expected)
 	}
-	got := GroupBy(input, func(str string) (float64, *wrapped) {
-		return float64(len(str)) * 10.0, &wrapped{str}
+	if empty := GroupByTwoPass([]int{}, func(n int) (int, int) { return n, n }); empty == nil || len(empty) != 0 {
+		t.Errorf("GroupByTwoPass([]) = %#v, expected an empty map", empty)
+	}
+}
+
+type deployment struct {
+	region, service, name string
+}
+
+func TestGroupBy2(t *testing.T) {
+	input := []deployment{
+		{"eu", "api", "a1"}, {"us", "api", "a2"}, {"eu", "db", "d1"}, {"eu", "api", "a3"},
+	}
+	result := GroupBy2(input,
+		func(d deployment) string { return d.region },
+		func(d deployment) string { return d.service },
+	)
+	expected := map[string]map[string][]deployment{
+		"eu": {"api": {input[0], input[3]}, "db": {input[2]}},
+		"us": {"api": {input[1]}},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("GroupBy2() = %v, expected %v", result, expected)
+	}
+	if result := GroupBy2([]deployment{}, func(d deployment) string { return d.region }, func(d deployment) int { return 0 }); len(result) != 0 {
+		t.Errorf("GroupBy2([]) = %v, expected an empty map", result)
+	}
+}
+
+func TestGroupByPath(t *testing.T) {
+	input := []deployment{
+		{"eu", "api", "a1"}, {"us", "api", "a2"}, {"eu", "db", "d1"}, {"eu", "api", "a3"},
+	}
+	region := func(d deployment) string { return d.region }
+	service := func(d deployment) string { return d.service }
+	tree := GroupByPath(input, region, service)
+	leaf := func(items ...deployment) *GroupNode[string, deployment] {
+		return &GroupNode[string, deployment]{Items: items}
+	}
+	expected := &GroupNode[string, deployment]{Children: map[string]*GroupNode[string, deployment]{
+		"eu": {Children: map[string]*GroupNode[string, deployment]{"api": leaf(input[0], input[3]), "db": leaf(input[2])}},
+		"us": {Children: map[string]*GroupNode[string, deployment]{"api": leaf(input[1])}},
+	}}
+	if !reflect.DeepEqual(tree, expected) {
+		t.Errorf("GroupByPath() = %+v, expected %+v", tree, expected)
+	}
+
+	if tree := GroupByPath[deployment, string](nil); tree.Children != nil || !reflect.DeepEqual(tree.Items, []deployment{}) {
+		t.Errorf("GroupByPath() without selectors = %+v, expected a leaf with no items", tree)
+	}
+}
+
+func TestCompositeKeys(t *testing.T) {
+	input := []deployment{{"eu", "api", "a1"}, {"us", "api", "a2"}, {"eu", "api", "a3"}, {"eu", "db", "d1"}}
+	byRegionAndService := GroupBy(input, func(d deployment) (Key2[string, string], string) {
+		return NewKey2(d.region, d.service), d.name
+	})
+	expected := map[Key2[string, string]][]string{
+		{"eu", "api"}: {"a1", "a3"},
+		{"us", "api"}: {"a2"},
+		{"eu", "db"}:  {"d1"},
+	}
+	if !reflect.DeepEqual(byRegionAndService, expected) {
+		t.Errorf("GroupBy(Key2) = %v, expected %v", byRegionAndService, expected)
+	}
+
+	index := Associate(input, func(d deployment) (Key3[string, string, string], deployment) {
+		return NewKey3(d.region, d.service, d.name), d
+	})
+	if got, ok := index[NewKey3("eu", "db", "d1")]; !ok || got != input[3] {
+		t.Errorf("Associate(Key3)[eu db d1] = %v, %v, expected %v, true", got, ok, input[3])
+	}
+	if _, ok := index[NewKey3("eu", "db", "a1")]; ok {
+		t.Errorf("Associate(Key3)[eu db a1] found, expected missing")
+	}
+}
+
+func TestGroupByWithNewTypesForKeyAndValue(t *testing.T) {
+	input := []string{"a", "abc", "ab", "def", "abcd"}
+	want := map[float64][]*wrapped{
+		10.0: {&wrapped{"a"}},
+		20.0: {&wrapped{"ab"}},
+		30.0: {&wrapped{"abc"}, &wrapped{"def"}},
+		40.0: {&wrapped{"abcd"}},
+	}
+	got := GroupBy(input, func(str string) (float64, *wrapped) {
+		return float64(len(str)) * 10.0, &wrapped{str}
 	})
 	for k, vs := range want {
 		avs, ok := got[k]
@@ -572,6 +1280,80 @@ func TestItems(t *testing.T) {
 	}
 }
 
+func TestSortedItems(t *testing.T) {
+	scores := map[string]int{"bob": 7, "ann": 9, "cid": 7}
+	byScoreThenName := func(a, b Pair[string, int]) int {
+		return cmp.Or(cmp.Compare(b.Second, a.Second), cmp.Compare(a.First, b.First))
+	}
+	expected := []Pair[string, int]{{"ann", 9}, {"bob", 7}, {"cid", 7}}
+	if got := SortedItems(scores, byScoreThenName); !reflect.DeepEqual(got, expected) {
+		t.Errorf("SortedItems() = %v, expected %v", got, expected)
+	}
+	if got := SortedItems(map[string]int(nil), byScoreThenName); !reflect.DeepEqual(got, []Pair[string, int]{}) {
+		t.Errorf("SortedItems(nil) = %v, expected []", got)
+	}
+}
+
+func TestKeysSortedAndValuesSortedByKey(t *testing.T) {
+	m := map[int]string{3: "c", 1: "a", 2: "b", -5: "z"}
+	if got, expected := KeysSorted(m), []int{-5, 1, 2, 3}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("KeysSorted() = %v, expected %v", got, expected)
+	}
+	if got, expected := ValuesSortedByKey(m), []string{"z", "a", "b", "c"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("ValuesSortedByKey() = %v, expected %v", got, expected)
+	}
+	var empty map[string]int
+	if got := KeysSorted(empty); !reflect.DeepEqual(got, []string{}) {
+		t.Errorf("KeysSorted(nil) = %v, expected []", got)
+	}
+	if got := ValuesSortedByKey(empty); !reflect.DeepEqual(got, []int{}) {
+		t.Errorf("ValuesSortedByKey(nil) = %v, expected []", got)
+	}
+}
+
+func TestIntersect(t *testing.T) {
+	testCases := []struct {
+		name     string
+		first    []int
+		second   []int
+		expected []int
+	}{
+		{
+			name:     "keeps order of first slice",
+			first:    []int{5, 1, 3, 1, 2},
+			second:   []int{2, 1, 9},
+			expected: []int{1, 2},
+		},
+		{
+			name:     "deduplicates",
+			first:    []int{4, 4, 4},
+			second:   []int{4, 4},
+			expected: []int{4},
+		},
+		{
+			name:     "disjoint slices",
+			first:    []int{1, 2},
+			second:   []int{3, 4},
+			expected: []int{},
+		},
+		{
+			name:     "empty second slice",
+			first:    []int{1, 2},
+			second:   []int{},
+			expected: []int{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			actual := Intersect(testCase.first, testCase.second)
+			if !reflect.DeepEqual(actual, testCase.expected) {
+				t.Errorf("Intersect() = %v, expected %v", actual, testCase.expected)
+			}
+		})
+	}
+}
+
 func TestMap(t *testing.T) {
 	type args struct {
 		elems []int
@@ -600,6 +1382,16 @@ func TestMap(t *testing.T) {
 	}
 }
 
+func TestMapTo(t *testing.T) {
+	destination := []string{"header"}
+	destination = MapTo([]int{1, 2}, destination, func(i int) string { return fmt.Sprint(i * 10) })
+	destination = MapTo([]int{3}, destination, func(i int) string { return fmt.Sprint(i * 10) })
+	expected := []string{"header", "10", "20", "30"}
+	if !reflect.DeepEqual(destination, expected) {
+		t.Errorf("MapTo() = %v, expected %v", destination, expected)
+	}
+}
+
 func TestFlatMapIndexed(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -732,6 +1524,128 @@ func TestFoldIndexed(t *testing.T) {
 	}
 }
 
+func TestFoldWhile(t *testing.T) {
+	testCases := []struct {
+		name       string
+		inputSlice []int
+		initial    int
+		combine    func(int, int) (int, bool)
+		expected   int
+	}{
+		{
+			name:       "sum until budget exceeded",
+			inputSlice: []int{3, 4, 5, 6},
+			initial:    0,
+			combine: func(acc, v int) (int, bool) {
+				if acc+v > 10 {
+					return acc, false
+				}
+				return acc + v, true
+			},
+			expected: 7,
+		},
+		{
+			name:       "never stops",
+			inputSlice: []int{1, 2, 3},
+			initial:    0,
+			combine:    func(acc, v int) (int, bool) { return acc + v, true },
+			expected:   6,
+		},
+		{
+			name:       "stop keeps value returned with false",
+			inputSlice: []int{1, 2, 3},
+			initial:    10,
+			combine:    func(acc, v int) (int, bool) { return acc * v, v < 2 },
+			expected:   20,
+		},
+		{
+			name:       "empty slice",
+			inputSlice: []int{},
+			initial:    42,
+			combine:    func(acc, v int) (int, bool) { return acc + v, true },
+			expected:   42,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			actual := FoldWhile(testCase.inputSlice, testCase.initial, testCase.combine)
+			if actual != testCase.expected {
+				t.Errorf("FoldWhile() = %v, expected %v", actual, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestEntryPredicates(t *testing.T) {
+	stock := map[string]int{"apples": 3, "pears": 0, "plums": 12}
+	inStock := func(_ string, count int) bool { return count > 0 }
+	startsWithP := func(name string, _ int) bool { return strings.HasPrefix(name, "p") }
+	tests := []struct {
+		name      string
+		predicate func(string, int) bool
+		any       bool
+		all       bool
+		count     int
+	}{
+		{"in stock", inStock, true, false, 2},
+		{"starts with p", startsWithP, true, false, 2},
+		{"named", func(name string, _ int) bool { return name != "" }, true, true, 3},
+		{"negative", func(_ string, count int) bool { return count < 0 }, false, false, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AnyEntry(stock, tt.predicate); got != tt.any {
+				t.Errorf("AnyEntry() = %v, expected %v", got, tt.any)
+			}
+			if got := NoneEntry(stock, tt.predicate); got != !tt.any {
+				t.Errorf("NoneEntry() = %v, expected %v", got, !tt.any)
+			}
+			if got := AllEntries(stock, tt.predicate); got != tt.all {
+				t.Errorf("AllEntries() = %v, expected %v", got, tt.all)
+			}
+			if got := CountEntries(stock, tt.predicate); got != tt.count {
+				t.Errorf("CountEntries() = %v, expected %v", got, tt.count)
+			}
+		})
+	}
+
+	var empty map[string]int
+	if AnyEntry(empty, inStock) || !AllEntries(empty, inStock) || !NoneEntry(empty, inStock) || CountEntries(empty, inStock) != 0 {
+		t.Errorf("entry predicates on a nil map should behave like an empty slice")
+	}
+}
+
+func TestFirstEntry(t *testing.T) {
+	stock := map[string]int{"apples": 3, "pears": 0, "plums": 12}
+	entry, ok := FirstEntry(stock, func(_ string, count int) bool { return count > 10 })
+	if expected := (Pair[string, int]{"plums", 12}); !ok || entry != expected {
+		t.Errorf("FirstEntry() = %v, %v, expected %v, true", entry, ok, expected)
+	}
+	entry, ok = FirstEntry(stock, func(_ string, count int) bool { return count > 100 })
+	if ok || entry != (Pair[string, int]{}) {
+		t.Errorf("FirstEntry() = %v, %v, expected zero, false", entry, ok)
+	}
+}
+
+func TestMaxMinByEntry(t *testing.T) {
+	counts := map[string]int{"go": 3, "rust": 2, "zig": 1}
+	byCount := func(_ string, count int) int { return count }
+	if entry, ok := MaxByEntry(counts, byCount); !ok || entry != (Pair[string, int]{"go", 3}) {
+		t.Errorf("MaxByEntry() = %v, %v, expected {go 3}, true", entry, ok)
+	}
+	if entry, ok := MinByEntry(counts, byCount); !ok || entry != (Pair[string, int]{"zig", 1}) {
+		t.Errorf("MinByEntry() = %v, %v, expected {zig 1}, true", entry, ok)
+	}
+	byKeyLength := func(key string, _ int) int { return len(key) }
+	if entry, ok := MaxByEntry(counts, byKeyLength); !ok || entry != (Pair[string, int]{"rust", 2}) {
+		t.Errorf("MaxByEntry(key length) = %v, %v, expected {rust 2}, true", entry, ok)
+	}
+	if entry, ok := MinByEntry(map[string]int{}, byCount); ok || entry != (Pair[string, int]{}) {
+		t.Errorf("MinByEntry(empty) = %v, %v, expected zero, false", entry, ok)
+	}
+}
+
 func TestFoldMapEntries(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -810,6 +1724,201 @@ func TestMapIndexed(t *testing.T) {
 	}
 }
 
+func TestBinarySearchBy(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+	users := []user{{2, "ann"}, {5, "bob"}, {9, "cy"}}
+	id := func(u user) int { return u.id }
+	testCases := []struct {
+		key           int
+		expectedIndex int
+		expectedFound bool
+	}{
+		{5, 1, true},
+		{1, 0, false},
+		{6, 2, false},
+		{10, 3, false},
+	}
+	for _, tc := range testCases {
+		index, found := BinarySearchBy(users, id, tc.key)
+		if index != tc.expectedIndex || found != tc.expectedFound {
+			t.Errorf("BinarySearchBy(%d) = %d, %v, expected %d, %v", tc.key, index, found, tc.expectedIndex, tc.expectedFound)
+		}
+	}
+}
+
+func TestInsertSorted(t *testing.T) {
+	var slice []int
+	for _, value := range []int{5, 1, 3, 3, 9, 0} {
+		slice = InsertSorted(slice, value)
+	}
+	if expected := []int{0, 1, 3, 3, 5, 9}; !reflect.DeepEqual(slice, expected) {
+		t.Errorf("InsertSorted() = %v, expected %v", slice, expected)
+	}
+}
+
+func TestPlus(t *testing.T) {
+	testCases := []struct {
+		name     string
+		slice    []int
+		elements []int
+		expected []int
+	}{
+		{
+			name:     "append elements",
+			slice:    []int{1, 2},
+			elements: []int{3, 4},
+			expected: []int{1, 2, 3, 4},
+		},
+		{
+			name:     "no elements",
+			slice:    []int{1, 2},
+			elements: nil,
+			expected: []int{1, 2},
+		},
+		{
+			name:     "empty slice",
+			slice:    []int{},
+			elements: []int{7},
+			expected: []int{7},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			actual := Plus(testCase.slice, testCase.elements...)
+			if !reflect.DeepEqual(actual, testCase.expected) {
+				t.Errorf("Plus() = %v, expected %v", actual, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestPlusDoesNotShareBackingArray(t *testing.T) {
+	original := make([]int, 2, 10)
+	result := Plus(original, 1)
+	result[0] = 99
+	if original[0] != 0 {
+		t.Errorf("Plus() modified the input slice: %v", original)
+	}
+}
+
+func TestMergeValues(t *testing.T) {
+	defaults := url.Values{"sort": {"name"}, "tag": {"go"}}
+	overrides := url.Values{"tag": {"kotlin"}, "page": {"2"}}
+	actual := MergeValues(defaults, overrides)
+	expected := url.Values{"sort": {"name"}, "tag": {"go", "kotlin"}, "page": {"2"}}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("MergeValues() = %v, expected %v", actual, expected)
+	}
+	if !reflect.DeepEqual(defaults["tag"], []string{"go"}) {
+		t.Errorf("MergeValues() modified its input: %v", defaults)
+	}
+}
+
+func TestMinus(t *testing.T) {
+	testCases := []struct {
+		name     string
+		slice    []int
+		other    []int
+		expected []int
+	}{
+		{
+			name:     "remove all occurrences",
+			slice:    []int{1, 2, 3, 2, 4, 1},
+			other:    []int{1, 2},
+			expected: []int{3, 4},
+		},
+		{
+			name:     "nothing to remove",
+			slice:    []int{1, 2, 3},
+			other:    []int{},
+			expected: []int{1, 2, 3},
+		},
+		{
+			name:     "remove everything",
+			slice:    []int{5, 5},
+			other:    []int{5},
+			expected: []int{},
+		},
+		{
+			name:     "empty slice",
+			slice:    []int{},
+			other:    []int{1},
+			expected: []int{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			actual := Minus(testCase.slice, testCase.other)
+			if !reflect.DeepEqual(actual, testCase.expected) {
+				t.Errorf("Minus() = %v, expected %v", actual, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestMinusElement(t *testing.T) {
+	testCases := []struct {
+		name     string
+		slice    []string
+		element  string
+		expected []string
+	}{
+		{
+			name:     "removes only the first occurrence",
+			slice:    []string{"a", "b", "a", "c"},
+			element:  "a",
+			expected: []string{"b", "a", "c"},
+		},
+		{
+			name:     "element absent",
+			slice:    []string{"a", "b"},
+			element:  "z",
+			expected: []string{"a", "b"},
+		},
+		{
+			name:     "empty slice",
+			slice:    []string{},
+			element:  "a",
+			expected: []string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			actual := MinusElement(testCase.slice, testCase.element)
+			if !reflect.DeepEqual(actual, testCase.expected) {
+				t.Errorf("MinusElement() = %v, expected %v", actual, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestMapInPlace(t *testing.T) {
+	input := []int{1, 2, 3}
+	actual := MapInPlace(input, func(x int) int { return x * x })
+	expected := []int{1, 4, 9}
+	if !reflect.DeepEqual(actual, expected) || !reflect.DeepEqual(input, expected) {
+		t.Errorf("MapInPlace() = %v, input = %v, expected %v", actual, input, expected)
+	}
+}
+
+func TestOnEach(t *testing.T) {
+	logged := 0
+	actual := Map(
+		OnEach([]int{1, 2, 3}, func(int) { logged++ }),
+		func(x int) int { return x * 2 },
+	)
+	expected := []int{2, 4, 6}
+	if !reflect.DeepEqual(actual, expected) || logged != 3 {
+		t.Errorf("OnEach() pipeline = %v with %d calls, expected %v with 3 calls", actual, logged, expected)
+	}
+}
+
 func TestPartition(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -854,6 +1963,95 @@ func TestPartition(t *testing.T) {
 	}
 }
 
+func TestSubSlice(t *testing.T) {
+	input := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	tests := []struct {
+		name     string
+		from, to int
+		expected []int
+	}{
+		{"plain", 2, 5, []int{2, 3, 4}},
+		{"last five except final", -5, -1, []int{5, 6, 7, 8}},
+		{"from negative to end", -3, 10, []int{7, 8, 9}},
+		{"clamped to", 8, 100, []int{8, 9}},
+		{"clamped from", -100, 2, []int{0, 1}},
+		{"crossed", 6, 3, []int{}},
+		{"entirely past end", 20, 30, []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SubSlice(input, tt.from, tt.to); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("SubSlice(%d, %d) = %v, expected %v", tt.from, tt.to, got, tt.expected)
+			}
+		})
+	}
+
+	view := SubSlice(input, 0, 2)
+	_ = append(view, 100)
+	if input[2] != 2 {
+		t.Errorf("appending to SubSlice() overwrote the input")
+	}
+	if got := SubSlice([]int(nil), -1, 5); !reflect.DeepEqual(got, []int{}) {
+		t.Errorf("SubSlice(nil) = %v, expected []", got)
+	}
+}
+
+func TestSlice(t *testing.T) {
+	input := []string{"a", "b", "c", "d"}
+	if got, expected := Slice(input, 3, 0, -1, 1, 1), []string{"d", "a", "d", "b", "b"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("Slice() = %v, expected %v", got, expected)
+	}
+	if got := Slice(input); !reflect.DeepEqual(got, []string{}) {
+		t.Errorf("Slice() without indices = %v, expected []", got)
+	}
+
+	defer func() {
+		if r := recover(); r != "Slice: index -5 out of range for length 4" {
+			t.Errorf("Slice() panic = %v, expected an out of range message", r)
+		}
+	}()
+	Slice(input, -5)
+}
+
+func TestPartitionN(t *testing.T) {
+	byRemainder := func(n int) int { return n % 3 }
+	tests := []struct {
+		name     string
+		input    []int
+		n        int
+		expected [][]int
+	}{
+		{"by remainder", []int{1, 2, 3, 4, 5, 6, 7}, 3, [][]int{{3, 6}, {1, 4, 7}, {2, 5}}},
+		{"empty buckets kept", []int{3, 6}, 3, [][]int{{3, 6}, {}, {}}},
+		{"empty input", nil, 3, [][]int{{}, {}, {}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PartitionN(tt.input, tt.n, byRemainder); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("PartitionN() = %v, expected %v", got, tt.expected)
+			}
+		})
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected a panic but did not get one")
+		}
+	}()
+	PartitionN([]int{5}, 2, byRemainder)
+}
+
+func TestPartitionBy(t *testing.T) {
+	words := []string{"kiwi", "fig", "plum", "apple", "pear", "date"}
+	expected := [][]string{{"kiwi", "plum", "pear", "date"}, {"fig"}, {"apple"}}
+	if got := PartitionBy(words, func(s string) int { return len(s) }); !reflect.DeepEqual(got, expected) {
+		t.Errorf("PartitionBy() = %v, expected %v", got, expected)
+	}
+	if got := PartitionBy([]string{}, func(s string) int { return len(s) }); !reflect.DeepEqual(got, [][]string{}) {
+		t.Errorf("PartitionBy([]) = %v, expected []", got)
+	}
+}
+
 func TestReduce(t *testing.T) {
 	type args struct {
 		s  []int
@@ -912,6 +2110,114 @@ func TestReduceIndexed(t *testing.T) {
 	}
 }
 
+func TestReduceWhile(t *testing.T) {
+	got := ReduceWhile([]int{5, 4, 3, 2, 1}, func(acc, v int) (int, bool) {
+		if acc+v > 10 {
+			return acc, false
+		}
+		return acc + v, true
+	})
+	if got != 9 {
+		t.Errorf("ReduceWhile() = %v, expected %v", got, 9)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected a panic but did not get one")
+		}
+	}()
+	ReduceWhile([]int{}, func(acc, v int) (int, bool) { return acc + v, true })
+}
+
+func TestOKVariants(t *testing.T) {
+	sum := func(acc, v int) int { return acc + v }
+	sumIndexed := func(i, acc, v int) int { return acc + i*v }
+	testCases := []struct {
+		name          string
+		fn            func([]int) (int, bool)
+		slice         []int
+		expected      int
+		expectedFound bool
+	}{
+		{"ReduceOK", func(s []int) (int, bool) { return ReduceOK(s, sum) }, []int{1, 2, 3}, 6, true},
+		{"ReduceOK empty", func(s []int) (int, bool) { return ReduceOK(s, sum) }, []int{}, 0, false},
+		{"ReduceIndexedOK", func(s []int) (int, bool) { return ReduceIndexedOK(s, sumIndexed) }, []int{1, 2, 3}, 9, true},
+		{"ReduceIndexedOK nil", func(s []int) (int, bool) { return ReduceIndexedOK(s, sumIndexed) }, nil, 0, false},
+		{"FirstOK", FirstOK[int], []int{4, 5}, 4, true},
+		{"FirstOK empty", FirstOK[int], []int{}, 0, false},
+		{"LastOK", LastOK[int], []int{4, 5}, 5, true},
+		{"LastOK empty", LastOK[int], []int{}, 0, false},
+		{"SingleOK", SingleOK[int], []int{7}, 7, true},
+		{"SingleOK many", SingleOK[int], []int{7, 8}, 0, false},
+		{"ElementAtOK", func(s []int) (int, bool) { return ElementAtOK(s, 1) }, []int{4, 5}, 5, true},
+		{"ElementAtOK out of range", func(s []int) (int, bool) { return ElementAtOK(s, 2) }, []int{4, 5}, 0, false},
+		{"ElementAtOK negative", func(s []int) (int, bool) { return ElementAtOK(s, -1) }, []int{4, 5}, 0, false},
+		{"MaxOK", MaxOK[int], []int{3, 9, 1}, 9, true},
+		{"MaxOK empty", MaxOK[int], []int{}, 0, false},
+		{"MinOK", MinOK[int], []int{3, 9, 1}, 1, true},
+		{"MinOK empty", MinOK[int], nil, 0, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, found := tc.fn(tc.slice)
+			if got != tc.expected || found != tc.expectedFound {
+				t.Errorf("%s(%v) = %v, %v, expected %v, %v", tc.name, tc.slice, got, found, tc.expected, tc.expectedFound)
+			}
+		})
+	}
+}
+
+func TestReduceOrDefault(t *testing.T) {
+	sum := func(a, b int) int { return a + b }
+	if got := ReduceOrDefault([]int{1, 2, 3}, sum, -1); got != 6 {
+		t.Errorf("ReduceOrDefault() = %v, expected 6", got)
+	}
+	if got := ReduceOrDefault([]int{4}, sum, -1); got != 4 {
+		t.Errorf("ReduceOrDefault([4]) = %v, expected 4", got)
+	}
+	if got := ReduceOrDefault(nil, sum, -1); got != -1 {
+		t.Errorf("ReduceOrDefault(nil) = %v, expected -1", got)
+	}
+}
+
+func TestElementAtOrDefault(t *testing.T) {
+	input := []string{"a", "b"}
+	tests := []struct {
+		index    int
+		expected string
+	}{{0, "a"}, {1, "b"}, {2, "none"}, {-1, "none"}}
+	for _, tt := range tests {
+		if got := ElementAtOrDefault(input, tt.index, "none"); got != tt.expected {
+			t.Errorf("ElementAtOrDefault(%d) = %q, expected %q", tt.index, got, tt.expected)
+		}
+	}
+
+	calls := 0
+	fallback := func(index int) string { calls++; return fmt.Sprintf("missing %d", index) }
+	if got := ElementAtOrElse(input, 1, fallback); got != "b" || calls != 0 {
+		t.Errorf("ElementAtOrElse(1) = %q with %d fallback calls, expected %q and none", got, calls, "b")
+	}
+	if got := ElementAtOrElse(input, 5, fallback); got != "missing 5" {
+		t.Errorf("ElementAtOrElse(5) = %q, expected %q", got, "missing 5")
+	}
+}
+
+func TestGetOrDefault(t *testing.T) {
+	m := map[string]int{"a": 1, "zero": 0}
+	if got := GetOrDefault(m, "a", -1); got != 1 {
+		t.Errorf("GetOrDefault(a) = %v, expected 1", got)
+	}
+	if got := GetOrDefault(m, "zero", -1); got != 0 {
+		t.Errorf("GetOrDefault(zero) = %v, expected 0", got)
+	}
+	if got := GetOrDefault(m, "b", -1); got != -1 || len(m) != 2 {
+		t.Errorf("GetOrDefault(b) = %v with map %v, expected -1 and the map unchanged", got, m)
+	}
+	if got := GetOrDefault(map[string]int(nil), "a", 7); got != 7 {
+		t.Errorf("GetOrDefault(nil) = %v, expected 7", got)
+	}
+}
+
 func TestTakeWhile(t *testing.T) {
 	got := TakeWhile(alphabet(), func(s rune) bool { return s < 'f' })
 	expected := []rune{'a', 'b', 'c', 'd', 'e'}
@@ -920,6 +2226,162 @@ func TestTakeWhile(t *testing.T) {
 	}
 }
 
+func TestTakeUntil(t *testing.T) {
+	testCases := []struct {
+		name      string
+		slice     []int
+		predicate func(int) bool
+		expected  []int
+	}{
+		{
+			name:      "includes boundary element",
+			slice:     []int{1, 2, 3, 4, 5},
+			predicate: func(x int) bool { return x == 3 },
+			expected:  []int{1, 2, 3},
+		},
+		{
+			name:      "no element matches",
+			slice:     []int{1, 2, 3},
+			predicate: func(x int) bool { return x > 10 },
+			expected:  []int{1, 2, 3},
+		},
+		{
+			name:      "first element matches",
+			slice:     []int{7, 8},
+			predicate: func(x int) bool { return x == 7 },
+			expected:  []int{7},
+		},
+		{
+			name:      "empty slice",
+			slice:     []int{},
+			predicate: func(x int) bool { return true },
+			expected:  []int{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			actual := TakeUntil(testCase.slice, testCase.predicate)
+			if !reflect.DeepEqual(actual, testCase.expected) {
+				t.Errorf("TakeUntil() = %v, expected %v", actual, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestMapKeysStrict(t *testing.T) {
+	headers := map[string]string{"Content-Type": "json", "X-Id": "7"}
+	result, err := MapKeysStrict(headers, strings.ToLower)
+	if expected := map[string]string{"content-type": "json", "x-id": "7"}; err != nil || !reflect.DeepEqual(result, expected) {
+		t.Errorf("MapKeysStrict() = %v, %v, expected %v, nil", result, err, expected)
+	}
+
+	result, err = MapKeysStrict(map[string]string{"Content-Type": "json", "content-type": "xml", "Accept": "*"}, strings.ToLower)
+	if result != nil || !errors.Is(err, ErrDuplicateKey) {
+		t.Fatalf("MapKeysStrict() = %v, %v, expected nil, %v", result, err, ErrDuplicateKey)
+	}
+	message := err.Error()
+	if message != "duplicate key content-type (from Content-Type and content-type)" &&
+		message != "duplicate key content-type (from content-type and Content-Type)" {
+		t.Errorf("MapKeysStrict() error = %q, expected it to name both original keys", message)
+	}
+
+	if result, err := MapKeysStrict(map[string]int(nil), strings.ToLower); err != nil || result == nil || len(result) != 0 {
+		t.Errorf("MapKeysStrict(nil) = %#v, %v, expected an empty map", result, err)
+	}
+}
+
+func TestElementsMatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     []string
+		expected bool
+	}{
+		{"same order", []string{"a", "b"}, []string{"a", "b"}, true},
+		{"reordered", []string{"a", "b", "a"}, []string{"a", "a", "b"}, true},
+		{"different counts", []string{"a", "a", "b"}, []string{"a", "b", "b"}, false},
+		{"different lengths", []string{"a"}, []string{"a", "a"}, false},
+		{"different elements", []string{"a", "b"}, []string{"a", "c"}, false},
+		{"nil and empty", nil, []string{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ElementsMatch(tt.a, tt.b); got != tt.expected {
+				t.Errorf("ElementsMatch(%v, %v) = %v, expected %v", tt.a, tt.b, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestHasPrefixAndSuffix(t *testing.T) {
+	path := []string{"usr", "local", "bin"}
+	tests := []struct {
+		name           string
+		affix          []string
+		prefix, suffix bool
+	}{
+		{"empty", []string{}, true, true},
+		{"first segment", []string{"usr"}, true, false},
+		{"last segments", []string{"local", "bin"}, false, true},
+		{"whole", path, true, true},
+		{"longer", []string{"usr", "local", "bin", "go"}, false, false},
+		{"unrelated", []string{"opt"}, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HasPrefix(path, tt.affix); got != tt.prefix {
+				t.Errorf("HasPrefix(%v) = %v, expected %v", tt.affix, got, tt.prefix)
+			}
+			if got := HasSuffix(path, tt.affix); got != tt.suffix {
+				t.Errorf("HasSuffix(%v) = %v, expected %v", tt.affix, got, tt.suffix)
+			}
+		})
+	}
+}
+
+func TestContainsSubslice(t *testing.T) {
+	tests := []struct {
+		slice, sub string
+		expected   bool
+	}{
+		{"abcabcabd", "abcabd", true},
+		{"aaaaab", "aaab", true},
+		{"abababc", "ababc", true},
+		{"abcabc", "abd", false},
+		{"abc", "", true},
+		{"", "a", false},
+		{"ab", "abc", false},
+		{"xyz", "z", true},
+	}
+	for _, tt := range tests {
+		got := ContainsSubslice([]byte(tt.slice), []byte(tt.sub))
+		if got != tt.expected || got != strings.Contains(tt.slice, tt.sub) {
+			t.Errorf("ContainsSubslice(%q, %q) = %v, expected %v", tt.slice, tt.sub, got, tt.expected)
+		}
+	}
+}
+
+func TestContainsSubsliceMatchesNaiveSearch(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	for range 2000 {
+		slice := make([]int, rng.IntN(20))
+		for i := range slice {
+			slice[i] = rng.IntN(3)
+		}
+		sub := make([]int, rng.IntN(5))
+		for i := range sub {
+			sub[i] = rng.IntN(3)
+		}
+		naive := false
+		for start := 0; start+len(sub) <= len(slice) && !naive; start++ {
+			naive = slices.Equal(slice[start:start+len(sub)], sub)
+		}
+		if got := ContainsSubslice(slice, sub); got != naive {
+			t.Fatalf("ContainsSubslice(%v, %v) = %v, expected %v", slice, sub, got, naive)
+		}
+	}
+}
+
 func TestMapEntries(t *testing.T) {
 	type args struct {
 		inputMap  map[string]int
@@ -982,6 +2444,173 @@ func TestMapEntries(t *testing.T) {
 	}
 }
 
+func TestUnion(t *testing.T) {
+	testCases := []struct {
+		name     string
+		first    []string
+		second   []string
+		expected []string
+	}{
+		{
+			name:     "first occurrence order",
+			first:    []string{"b", "a", "b"},
+			second:   []string{"c", "a", "d"},
+			expected: []string{"b", "a", "c", "d"},
+		},
+		{
+			name:     "empty first slice",
+			first:    []string{},
+			second:   []string{"x", "x"},
+			expected: []string{"x"},
+		},
+		{
+			name:     "both empty",
+			first:    []string{},
+			second:   []string{},
+			expected: []string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			actual := Union(testCase.first, testCase.second)
+			if !reflect.DeepEqual(actual, testCase.expected) {
+				t.Errorf("Union() = %v, expected %v", actual, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestPrefixKeys(t *testing.T) {
+	actual := PrefixKeys(map[string]int{"host": 1, "port": 2}, "db.")
+	expected := map[string]int{"db.host": 1, "db.port": 2}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("PrefixKeys() = %v, expected %v", actual, expected)
+	}
+}
+
+func TestFilterKeysByPrefix(t *testing.T) {
+	config := map[string]string{"db.host": "localhost", "db.port": "5432", "http.port": "8080"}
+	actual := FilterKeysByPrefix(config, "db.")
+	expected := map[string]string{"db.host": "localhost", "db.port": "5432"}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("FilterKeysByPrefix() = %v, expected %v", actual, expected)
+	}
+}
+
+func TestStripKeyPrefix(t *testing.T) {
+	config := map[string]string{"db.host": "localhost", "db.port": "5432", "http.port": "8080"}
+	actual := StripKeyPrefix(config, "db.")
+	expected := map[string]string{"host": "localhost", "port": "5432"}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("StripKeyPrefix() = %v, expected %v", actual, expected)
+	}
+}
+
+func TestPartitionKeysByPrefix(t *testing.T) {
+	testCases := []struct {
+		name      string
+		inputMap  map[string]string
+		separator string
+		expected  map[string]map[string]string
+	}{
+		{
+			name: "split on first separator",
+			inputMap: map[string]string{
+				"db.host":         "localhost",
+				"db.pool.max":     "10",
+				"http.port":       "8080",
+				"standalone_flag": "on",
+			},
+			separator: ".",
+			expected: map[string]map[string]string{
+				"db":   {"host": "localhost", "pool.max": "10"},
+				"http": {"port": "8080"},
+				"":     {"standalone_flag": "on"},
+			},
+		},
+		{
+			name:      "empty map",
+			inputMap:  map[string]string{},
+			separator: ".",
+			expected:  map[string]map[string]string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			actual := PartitionKeysByPrefix(testCase.inputMap, testCase.separator)
+			if !reflect.DeepEqual(actual, testCase.expected) {
+				t.Errorf("PartitionKeysByPrefix() = %v, expected %v", actual, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestProject(t *testing.T) {
+	type record struct {
+		name  string
+		age   int
+		admin bool
+	}
+	records := []record{{"ann", 31, true}, {"bob", 27, false}}
+	name := func(r record) string { return r.name }
+	age := func(r record) int { return r.age }
+	admin := func(r record) bool { return r.admin }
+
+	names, ages := Project2(records, name, age)
+	if !reflect.DeepEqual(names, []string{"ann", "bob"}) || !reflect.DeepEqual(ages, []int{31, 27}) {
+		t.Errorf("Project2() = %v, %v, expected [ann bob], [31 27]", names, ages)
+	}
+	names, ages, admins := Project3(records, name, age, admin)
+	if !reflect.DeepEqual(names, []string{"ann", "bob"}) || !reflect.DeepEqual(ages, []int{31, 27}) || !reflect.DeepEqual(admins, []bool{true, false}) {
+		t.Errorf("Project3() = %v, %v, %v, expected [ann bob], [31 27], [true false]", names, ages, admins)
+	}
+	names, ages = Project2([]record(nil), name, age)
+	if !reflect.DeepEqual(names, []string{}) || !reflect.DeepEqual(ages, []int{}) {
+		t.Errorf("Project2(nil) = %#v, %#v, expected empty slices", names, ages)
+	}
+}
+
+func TestIntersperse(t *testing.T) {
+	testCases := []struct {
+		name     string
+		slice    []string
+		expected []string
+	}{
+		{"several", []string{"a", "b", "c"}, []string{"a", ",", "b", ",", "c"}},
+		{"single", []string{"a"}, []string{"a"}},
+		{"empty", nil, []string{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := Intersperse(tc.slice, ","); !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("Intersperse(%q) = %q, expected %q", tc.slice, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestInterleave(t *testing.T) {
+	testCases := []struct {
+		name     string
+		slices   [][]int
+		expected []int
+	}{
+		{"equal lengths", [][]int{{1, 2}, {10, 20}}, []int{1, 10, 2, 20}},
+		{"uneven lengths", [][]int{{1}, {10, 20, 30}, {100, 200}}, []int{1, 10, 100, 20, 200, 30}},
+		{"with empty", [][]int{{}, {1, 2}}, []int{1, 2}},
+		{"no slices", nil, []int{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := Interleave(tc.slices...); !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("Interleave(%v) = %v, expected %v", tc.slices, got, tc.expected)
+			}
+		})
+	}
+}
+
 func TestUnzip(t *testing.T) {
 	type args struct {
 		pairs []Pair[string, int]
@@ -1210,6 +2839,159 @@ func TestWindowed(t *testing.T) {
 	}
 }
 
+func TestWindowedView(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5, 6, 7}
+	for _, shape := range [][2]int{{2, 2}, {2, 1}, {3, 1}, {3, 5}, {10, 3}} {
+		if got, expected := WindowedView(input, shape[0], shape[1]), Windowed(input, shape[0], shape[1]); !reflect.DeepEqual(got, expected) {
+			t.Errorf("WindowedView(%d, %d) = %v, expected %v", shape[0], shape[1], got, expected)
+		}
+	}
+	if got := WindowedView([]int{}, 2, 1); !reflect.DeepEqual(got, [][]int{}) {
+		t.Errorf("WindowedView([]) = %v, expected []", got)
+	}
+
+	windows := WindowedView(input, 2, 1)
+	windows[0][1] = 20
+	if input[1] != 20 || windows[1][0] != 20 {
+		t.Errorf("WindowedView() windows do not share the input's backing array")
+	}
+	_ = append(windows[0], 99)
+	if input[2] != 3 {
+		t.Errorf("appending to a WindowedView() window overwrote the input")
+	}
+}
+
+func TestChunkedByView(t *testing.T) {
+	ascending := func(prev, next int) bool { return prev < next }
+	input := []int{10, 20, 30, 40, 31, 31, 33, 34, 21}
+	if got, expected := ChunkedByView(input, ascending), ChunkedBy(input, ascending); !reflect.DeepEqual(got, expected) {
+		t.Errorf("ChunkedByView() = %v, expected %v", got, expected)
+	}
+	if got := ChunkedByView([]int{}, ascending); !reflect.DeepEqual(got, [][]int{}) {
+		t.Errorf("ChunkedByView([]) = %v, expected []", got)
+	}
+
+	chunks := ChunkedByView(input, ascending)
+	chunks[1][0] = -1
+	_ = append(chunks[0], 99)
+	if input[4] != -1 || input[4] == 99 {
+		t.Errorf("ChunkedByView() input = %v, expected writes shared and appends isolated", input)
+	}
+}
+
+func TestEmptyIfNil(t *testing.T) {
+	if actual := EmptyIfNil[int](nil); actual == nil || len(actual) != 0 {
+		t.Errorf("EmptyIfNil(nil) = %#v, expected an empty non-nil slice", actual)
+	}
+	input := []int{1, 2}
+	if actual := EmptyIfNil(input); &actual[0] != &input[0] {
+		t.Errorf("EmptyIfNil() copied a non-nil slice")
+	}
+}
+
+func TestNilMapSafeGetOrPut(t *testing.T) {
+	var nilMap map[string]int
+	value, err := NilMapSafeGetOrPut(nilMap, "a", func(string) int { return 1 })
+	if !errors.Is(err, ErrNilMap) || value != 0 {
+		t.Errorf("NilMapSafeGetOrPut(nil) = %v, %v, expected 0, %v", value, err, ErrNilMap)
+	}
+
+	m := map[string]int{"a": 5}
+	value, err = NilMapSafeGetOrPut(m, "b", func(string) int { return 7 })
+	if err != nil || value != 7 || m["b"] != 7 {
+		t.Errorf("NilMapSafeGetOrPut() = %v, %v with map %v, expected 7, nil", value, err, m)
+	}
+}
+
+func TestNilInputs(t *testing.T) {
+	var nilSlice []int
+	var nilMap map[int]int
+	always := func(int) bool { return true }
+	identity := func(x int) int { return x }
+	toEntry := func(x int) (int, int) { return x, x }
+
+	sliceResults := map[string]func() []int{
+		"Distinct":        func() []int { return Distinct(nilSlice) },
+		"DistinctBy":      func() []int { return DistinctBy(nilSlice, identity) },
+		"DistinctInPlace": func() []int { return DistinctInPlace(nilSlice) },
+		"DropLastWhile":   func() []int { return DropLastWhile(nilSlice, always) },
+		"DropUntil":       func() []int { return DropUntil(nilSlice, always) },
+		"DropWhile":       func() []int { return DropWhile(nilSlice, always) },
+		"EmptyIfNil":      func() []int { return EmptyIfNil(nilSlice) },
+		"Filter":          func() []int { return Filter(nilSlice, always) },
+		"FilterInPlace":   func() []int { return FilterInPlace(nilSlice, always) },
+		"FilterIndexed":   func() []int { return FilterIndexed(nilSlice, func(int, int) bool { return true }) },
+		"FilterTo":        func() []int { return FilterTo(nilSlice, nil, always) },
+		"FlatMap":         func() []int { return FlatMap(nilSlice, func(x int) []int { return []int{x} }) },
+		"Intersect":       func() []int { return Intersect(nilSlice, nilSlice) },
+		"Map":             func() []int { return Map(nilSlice, identity) },
+		"MapInPlace":      func() []int { return MapInPlace(nilSlice, identity) },
+		"MapTo":           func() []int { return MapTo(nilSlice, nil, identity) },
+		"Minus":           func() []int { return Minus(nilSlice, nilSlice) },
+		"MinusElement":    func() []int { return MinusElement(nilSlice, 1) },
+		"MovingMax":       func() []int { return MovingMax(nilSlice, 2) },
+		"MovingSum":       func() []int { return MovingSum(nilSlice, 2) },
+		"OnEach":          func() []int { return OnEach(nilSlice, func(int) {}) },
+		"Plus":            func() []int { return Plus(nilSlice) },
+		"TakeLastWhile":   func() []int { return TakeLastWhile(nilSlice, always) },
+		"TakeUntil":       func() []int { return TakeUntil(nilSlice, always) },
+		"TakeWhile":       func() []int { return TakeWhile(nilSlice, always) },
+		"Union":           func() []int { return Union(nilSlice, nilSlice) },
+	}
+	for name, result := range sliceResults {
+		t.Run(name, func(t *testing.T) {
+			if actual := result(); actual == nil || len(actual) != 0 {
+				t.Errorf("%s(nil) = %#v, expected an empty non-nil slice", name, actual)
+			}
+		})
+	}
+
+	mapResults := map[string]func() map[int]int{
+		"Associate":   func() map[int]int { return Associate(nilSlice, toEntry) },
+		"AssociateTo": func() map[int]int { return AssociateTo([]int{1}, nilMap, toEntry) },
+		"MapEntries":  func() map[int]int { return MapEntries(nilMap, func(k, v int) (int, int) { return k, v }) },
+	}
+	for name, result := range mapResults {
+		t.Run(name, func(t *testing.T) {
+			if actual := result(); actual == nil {
+				t.Errorf("%s() with nil input returned a nil map", name)
+			}
+		})
+	}
+
+	t.Run("GroupByTo", func(t *testing.T) {
+		actual := GroupByTo([]int{1, 2}, map[bool][]int(nil), func(x int) (bool, int) { return x%2 == 0, x })
+		expected := map[bool][]int{false: {1}, true: {2}}
+		if !reflect.DeepEqual(actual, expected) {
+			t.Errorf("GroupByTo() with nil destination = %v, expected %v", actual, expected)
+		}
+	})
+
+	t.Run("read-only functions", func(t *testing.T) {
+		if !All(nilSlice, always) || Any(nilSlice, always) {
+			t.Errorf("All/Any on nil slice returned unexpected results")
+		}
+		if Fold(nilSlice, 3, func(acc, x int) int { return acc + x }) != 3 {
+			t.Errorf("Fold on nil slice did not return the initial value")
+		}
+		if FoldMapEntries(nilMap, 3, func(acc, k, v int) int { return acc + k }) != 3 {
+			t.Errorf("FoldMapEntries on nil map did not return the initial value")
+		}
+		if items := Items(nilMap); items == nil || len(items) != 0 {
+			t.Errorf("Items(nil) = %#v, expected an empty non-nil slice", items)
+		}
+		if _, found := FirstValue(map[int][]int(nil), 1); found {
+			t.Errorf("FirstValue(nil) reported a value")
+		}
+		if windows := Windowed(nilSlice, 2, 1); windows == nil || len(windows) != 0 {
+			t.Errorf("Windowed(nil) = %#v, expected an empty non-nil slice", windows)
+		}
+		if chunks := ChunkedBy(nilSlice, func(int, int) bool { return true }); chunks == nil || len(chunks) != 0 {
+			t.Errorf("ChunkedBy(nil) = %#v, expected an empty non-nil slice", chunks)
+		}
+	})
+}
+
 func alphabet() []rune {
 	ret := make([]rune, 0)
 	for r := 'a'; r <= 'z'; r++ {
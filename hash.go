@@ -0,0 +1,55 @@
+package godelin
+
+import (
+	"fmt"
+	"hash/fnv"
+)
+
+// stableHash hashes the dynamic type and %#v formatting of value, so the result is the same
+// across processes for value types (values holding pointers or channels may format addresses
+// and are only stable in-process), and 1 and "1" in an interface-typed collection differ.
+func stableHash[T comparable](value T) uint64 {
+	hasher := fnv.New64a()
+	_, _ = fmt.Fprintf(hasher, "%T %#v", value, value)
+	return hasher.Sum64()
+}
+
+// mix64 is the splitmix64 finalizer, used to spread combined hashes over all 64 bits.
+func mix64(z uint64) uint64 {
+	z = (z ^ (z >> 30)) * 0xBF58476D1CE4E5B9
+	z = (z ^ (z >> 27)) * 0x94D049BB133111EB
+	return z ^ (z >> 31)
+}
+
+// FingerprintSlice returns an order-sensitive hash of the elements, stable across processes
+// under the same conditions as the elements' %#v formatting.
+func FingerprintSlice[T comparable](slice []T) uint64 {
+	fingerprint := mix64(uint64(len(slice)))
+	for _, element := range slice {
+		fingerprint = mix64(fingerprint + stableHash(element))
+	}
+	return fingerprint
+}
+
+// FingerprintSet returns an order-insensitive hash of the distinct elements, so reordering or
+// repeating elements does not change it.
+func FingerprintSet[T comparable](slice []T) uint64 {
+	seen := make(map[T]struct{}, len(slice))
+	var sum uint64
+	for _, element := range slice {
+		if _, exists := seen[element]; !exists {
+			seen[element] = struct{}{}
+			sum += mix64(stableHash(element))
+		}
+	}
+	return mix64(sum + uint64(len(seen)))
+}
+
+// FingerprintMap returns a hash of the entries that does not depend on iteration order.
+func FingerprintMap[M ~map[K]V, K, V comparable](m M) uint64 {
+	var sum uint64
+	for key, value := range m {
+		sum += mix64(stableHash(key) + mix64(stableHash(value)))
+	}
+	return mix64(sum + uint64(len(m)))
+}
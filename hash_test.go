@@ -0,0 +1,75 @@
+package godelin
+
+import "testing"
+
+func TestFingerprintSlice(t *testing.T) {
+	base := FingerprintSlice([]string{"a", "b", "c"})
+	if FingerprintSlice([]string{"a", "b", "c"}) != base {
+		t.Errorf("FingerprintSlice() differs for equal slices")
+	}
+	for _, other := range [][]string{{"c", "b", "a"}, {"a", "b"}, {"a", "b", "c", "c"}, {"ab", "c"}, {}} {
+		if FingerprintSlice(other) == base {
+			t.Errorf("FingerprintSlice(%q) = FingerprintSlice([a b c])", other)
+		}
+	}
+	if FingerprintSlice([]int(nil)) != FingerprintSlice([]int{}) {
+		t.Errorf("FingerprintSlice(nil) differs from FingerprintSlice([])")
+	}
+	if FingerprintSlice([]int{0}) == FingerprintSlice([]int{}) {
+		t.Errorf("FingerprintSlice([0]) = FingerprintSlice([])")
+	}
+}
+
+func TestFingerprintSet(t *testing.T) {
+	base := FingerprintSet([]int{1, 2, 3})
+	for _, same := range [][]int{{3, 2, 1}, {2, 3, 1, 1, 2}} {
+		if FingerprintSet(same) != base {
+			t.Errorf("FingerprintSet(%v) differs from FingerprintSet([1 2 3])", same)
+		}
+	}
+	for _, other := range [][]int{{1, 2}, {1, 2, 4}, {1, 2, 3, 4}, {}} {
+		if FingerprintSet(other) == base {
+			t.Errorf("FingerprintSet(%v) = FingerprintSet([1 2 3])", other)
+		}
+	}
+}
+
+func TestFingerprintMap(t *testing.T) {
+	config := map[string]string{"host": "db", "port": "5432", "user": "app"}
+	base := FingerprintMap(config)
+	for range 10 {
+		if FingerprintMap(map[string]string{"user": "app", "port": "5432", "host": "db"}) != base {
+			t.Fatalf("FingerprintMap() depends on iteration order")
+		}
+	}
+	changes := []map[string]string{
+		{"host": "db", "port": "5433", "user": "app"},
+		{"host": "db", "port": "5432"},
+		{"host": "db", "port": "5432", "user": "app", "tls": "on"},
+		{"host": "5432", "port": "db", "user": "app"},
+		{"hostport": "db5432", "user": "app"},
+	}
+	for _, changed := range changes {
+		if FingerprintMap(changed) == base {
+			t.Errorf("FingerprintMap(%v) = FingerprintMap(%v)", changed, config)
+		}
+	}
+}
+
+func TestFingerprintsDistinguishTypes(t *testing.T) {
+	if FingerprintSlice([]any{1}) == FingerprintSlice([]any{"1"}) {
+		t.Errorf("FingerprintSlice([1]) = FingerprintSlice([\"1\"])")
+	}
+	if FingerprintSlice([]any{1}) == FingerprintSlice([]any{int64(1)}) {
+		t.Errorf("FingerprintSlice([int 1]) = FingerprintSlice([int64 1])")
+	}
+	if FingerprintSet([]any{true}) == FingerprintSet([]any{"true"}) {
+		t.Errorf("FingerprintSet([true]) = FingerprintSet([\"true\"])")
+	}
+	if FingerprintMap(map[string]any{"a": 1}) == FingerprintMap(map[string]any{"a": "1"}) {
+		t.Errorf("FingerprintMap({a: 1}) = FingerprintMap({a: \"1\"})")
+	}
+	if FingerprintMap(map[string]any{"a": 1}) != FingerprintMap(map[string]any{"a": 1}) {
+		t.Errorf("FingerprintMap() differs for equal maps")
+	}
+}
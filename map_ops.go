@@ -0,0 +1,70 @@
+package godelin
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrInvalidMapOp = errors.New("invalid map operation")
+
+type MapOpKind int
+
+const (
+	MapPut MapOpKind = iota
+	MapDelete
+)
+
+type MapOp[K comparable, V any] struct {
+	Kind  MapOpKind
+	Key   K
+	Value V
+}
+
+func PutOp[K comparable, V any](key K, value V) MapOp[K, V] {
+	return MapOp[K, V]{Kind: MapPut, Key: key, Value: value}
+}
+
+func DeleteOp[K comparable, V any](key K) MapOp[K, V] {
+	return MapOp[K, V]{Kind: MapDelete, Key: key}
+}
+
+// ApplyOps validates every operation before touching m, so on error m is left unchanged.
+// The returned undo restores each touched key to its state before the batch.
+func ApplyOps[M ~map[K]V, K comparable, V any](m M, ops []MapOp[K, V]) (undo func(), err error) {
+	for i, op := range ops {
+		switch op.Kind {
+		case MapPut:
+			if m == nil {
+				return nil, fmt.Errorf("op %d: %w", i, ErrNilMap)
+			}
+		case MapDelete:
+		default:
+			return nil, fmt.Errorf("op %d: %w: unknown kind %d", i, ErrInvalidMapOp, op.Kind)
+		}
+	}
+	type previous struct {
+		value  V
+		exists bool
+	}
+	saved := make(map[K]previous, len(ops))
+	for _, op := range ops {
+		if _, seen := saved[op.Key]; !seen {
+			value, exists := m[op.Key]
+			saved[op.Key] = previous{value, exists}
+		}
+		if op.Kind == MapPut {
+			m[op.Key] = op.Value
+		} else {
+			delete(m, op.Key)
+		}
+	}
+	return func() {
+		for key, state := range saved {
+			if state.exists {
+				m[key] = state.value
+			} else {
+				delete(m, key)
+			}
+		}
+	}, nil
+}
@@ -0,0 +1,63 @@
+package godelin
+
+import (
+	"errors"
+	"maps"
+	"reflect"
+	"testing"
+)
+
+func TestApplyOps(t *testing.T) {
+	original := map[string]int{"a": 1, "b": 2}
+	m := maps.Clone(original)
+	undo, err := ApplyOps(m, []MapOp[string, int]{
+		PutOp("a", 10),
+		DeleteOp[string, int]("b"),
+		PutOp("c", 3),
+		PutOp("c", 4),
+		DeleteOp[string, int]("missing"),
+	})
+	if err != nil {
+		t.Fatalf("ApplyOps() unexpected error: %v", err)
+	}
+	if expected := map[string]int{"a": 10, "c": 4}; !reflect.DeepEqual(m, expected) {
+		t.Errorf("ApplyOps() = %v, expected %v", m, expected)
+	}
+	undo()
+	if !reflect.DeepEqual(m, original) {
+		t.Errorf("after undo = %v, expected %v", m, original)
+	}
+}
+
+func TestApplyOpsRejectsWholeBatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		m        map[string]int
+		ops      []MapOp[string, int]
+		expected error
+	}{
+		{"unknown kind", map[string]int{"a": 1}, []MapOp[string, int]{PutOp("a", 2), {Kind: 7, Key: "b"}}, ErrInvalidMapOp},
+		{"put into nil map", nil, []MapOp[string, int]{PutOp("a", 1)}, ErrNilMap},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := maps.Clone(tt.m)
+			undo, err := ApplyOps(tt.m, tt.ops)
+			if !errors.Is(err, tt.expected) || undo != nil {
+				t.Errorf("ApplyOps() error = %v, expected %v", err, tt.expected)
+			}
+			if !reflect.DeepEqual(tt.m, before) {
+				t.Errorf("ApplyOps() modified the map to %v on error", tt.m)
+			}
+		})
+	}
+}
+
+func TestApplyOpsDeleteFromNilMap(t *testing.T) {
+	var m map[string]int
+	undo, err := ApplyOps(m, []MapOp[string, int]{DeleteOp[string, int]("a")})
+	if err != nil {
+		t.Fatalf("ApplyOps() unexpected error: %v", err)
+	}
+	undo()
+}
@@ -0,0 +1,62 @@
+package godelin
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTranspose(t *testing.T) {
+	testCases := []struct {
+		name     string
+		matrix   [][]int
+		expected [][]int
+	}{
+		{"rectangular", [][]int{{1, 2, 3}, {4, 5, 6}}, [][]int{{1, 4}, {2, 5}, {3, 6}}},
+		{"single row", [][]int{{1, 2}}, [][]int{{1}, {2}}},
+		{"empty", [][]int{}, [][]int{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := Transpose(tc.matrix); !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("Transpose() = %v, expected %v", got, tc.expected)
+			}
+			for _, blockSize := range []int{1, 2, 64} {
+				if got := TransposeBlocked(tc.matrix, blockSize); !reflect.DeepEqual(got, tc.expected) {
+					t.Errorf("TransposeBlocked(%d) = %v, expected %v", blockSize, got, tc.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestTransposeBlockedMatchesTranspose(t *testing.T) {
+	matrix := MapMatrixBlocked(newMatrix[int](37, 53), 8, func(i, j, _ int) int { return i*100 + j })
+	if got, expected := TransposeBlocked(matrix, 16), Transpose(matrix); !reflect.DeepEqual(got, expected) {
+		t.Errorf("TransposeBlocked() differs from Transpose() on a 37×53 matrix")
+	}
+}
+
+func TestMapMatrix(t *testing.T) {
+	matrix := [][]int{{1, 2}, {3, 4}, {5, 6}}
+	if got, expected := MapMatrix(matrix, func(v int) int { return v * 10 }), [][]int{{10, 20}, {30, 40}, {50, 60}}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("MapMatrix() = %v, expected %v", got, expected)
+	}
+	positions := MapMatrixBlocked(matrix, 2, func(i, j, v int) Pair[int, int] { return Pair[int, int]{i, j} })
+	if positions[2][1] != (Pair[int, int]{2, 1}) {
+		t.Errorf("MapMatrixBlocked() passed position %v for cell (2, 1)", positions[2][1])
+	}
+	result := MapMatrix(matrix, func(v int) int { return v })
+	_ = append(result[0], 99)
+	if result[1][0] != 3 {
+		t.Errorf("appending to a result row overflowed into the next row: %v", result)
+	}
+}
+
+func TestMatrixPanicsOnRaggedRows(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected a panic but did not get one")
+		}
+	}()
+	Transpose([][]int{{1, 2}, {3}})
+}
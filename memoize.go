@@ -0,0 +1,73 @@
+package godelin
+
+import (
+	"sync"
+	"time"
+)
+
+// Memoize caches every result of fn. It is safe for concurrent use; fn runs outside the lock,
+// so concurrent first calls with the same key may each compute the value once.
+func Memoize[K comparable, V any](fn func(K) V) func(K) V {
+	var mu sync.Mutex
+	cache := make(map[K]V)
+	return func(key K) V {
+		mu.Lock()
+		value, exists := cache[key]
+		mu.Unlock()
+		if exists {
+			return value
+		}
+		value = fn(key)
+		mu.Lock()
+		cache[key] = value
+		mu.Unlock()
+		return value
+	}
+}
+
+type memoEntry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// MemoizeWithTTL caches results for ttl and keeps at most maxEntries of them, evicting the
+// oldest computed entry first. A maxEntries of zero or less means unbounded. Expired entries
+// are dropped whenever a new result is stored.
+func MemoizeWithTTL[K comparable, V any](fn func(K) V, ttl time.Duration, maxEntries int) func(K) V {
+	return memoizeWithTTL(fn, ttl, maxEntries, time.Now, NewOrderedMap[K, memoEntry[V]]())
+}
+
+func memoizeWithTTL[K comparable, V any](fn func(K) V, ttl time.Duration, maxEntries int, now func() time.Time, cache *OrderedMap[K, memoEntry[V]]) func(K) V {
+	if ttl <= 0 {
+		panic("MemoizeWithTTL: ttl must be positive")
+	}
+	var mu sync.Mutex
+	return func(key K) V {
+		mu.Lock()
+		entry, exists := cache.Get(key)
+		mu.Unlock()
+		if exists && now().Before(entry.expiresAt) {
+			return entry.value
+		}
+		value := fn(key)
+		mu.Lock()
+		defer mu.Unlock()
+		current := now()
+		cache.Delete(key)
+		// Entries are stored in the order they were computed and share one ttl, so the
+		// expired ones are always the oldest.
+		for {
+			oldest, entry, exists := cache.Oldest()
+			if !exists || current.Before(entry.expiresAt) {
+				break
+			}
+			cache.Delete(oldest)
+		}
+		if maxEntries > 0 && cache.Len() >= maxEntries {
+			oldest, _, _ := cache.Oldest()
+			cache.Delete(oldest)
+		}
+		cache.Set(key, memoEntry[V]{value: value, expiresAt: current.Add(ttl)})
+		return value
+	}
+}
@@ -0,0 +1,108 @@
+package godelin
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func countingSquare() (func(int) int, *int) {
+	calls := 0
+	return func(n int) int {
+		calls++
+		return n * n
+	}, &calls
+}
+
+func fakeClock() (*time.Time, func() time.Time) {
+	current := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	return &current, func() time.Time { return current }
+}
+
+func TestMemoize(t *testing.T) {
+	square, calls := countingSquare()
+	memoized := Memoize(square)
+	actual := Map([]int{2, 3, 2, 2, 3}, memoized)
+	if expected := []int{4, 9, 4, 4, 9}; !reflect.DeepEqual(actual, expected) || *calls != 2 {
+		t.Errorf("Memoize() = %v after %d calls, expected %v after 2", actual, *calls, expected)
+	}
+}
+
+func TestMemoizeIsSafeForConcurrentUse(t *testing.T) {
+	memoized := Memoize(func(n int) int { return n + 1 })
+	var wg sync.WaitGroup
+	for i := range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if memoized(i%5) != i%5+1 {
+				t.Errorf("memoized(%d) returned a wrong value", i%5)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestMemoizeWithTTLExpires(t *testing.T) {
+	clock, now := fakeClock()
+	square, calls := countingSquare()
+	memoized := memoizeWithTTL(square, time.Minute, 0, now, NewOrderedMap[int, memoEntry[int]]())
+
+	memoized(4)
+	*clock = clock.Add(30 * time.Second)
+	memoized(4)
+	if *calls != 1 {
+		t.Errorf("fn called %d times within the ttl, expected 1", *calls)
+	}
+	*clock = clock.Add(31 * time.Second)
+	if memoized(4) != 16 || *calls != 2 {
+		t.Errorf("fn called %d times after expiry, expected 2", *calls)
+	}
+}
+
+func TestMemoizeWithTTLEvictsOldest(t *testing.T) {
+	_, now := fakeClock()
+	square, calls := countingSquare()
+	memoized := memoizeWithTTL(square, time.Hour, 2, now, NewOrderedMap[int, memoEntry[int]]())
+
+	memoized(1)
+	memoized(2)
+	memoized(3) // evicts 1
+	memoized(2)
+	memoized(3)
+	if *calls != 3 {
+		t.Errorf("fn called %d times, expected 3", *calls)
+	}
+	memoized(1)
+	if *calls != 4 {
+		t.Errorf("evicted key was not recomputed, fn called %d times", *calls)
+	}
+}
+
+func TestMemoizeWithTTLDropsExpiredEntries(t *testing.T) {
+	clock, now := fakeClock()
+	square, _ := countingSquare()
+	cache := NewOrderedMap[int, memoEntry[int]]()
+	memoized := memoizeWithTTL(square, time.Minute, 0, now, cache)
+
+	for n := range 100 {
+		memoized(n)
+	}
+	*clock = clock.Add(30 * time.Second)
+	memoized(100)
+	*clock = clock.Add(31 * time.Second)
+	memoized(101)
+	if expected := []int{100, 101}; !reflect.DeepEqual(cache.Keys(), expected) {
+		t.Errorf("cached keys = %v, expected %v", cache.Keys(), expected)
+	}
+}
+
+func TestMemoizeWithTTLPanicsOnInvalidTTL(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected a panic but did not get one")
+		}
+	}()
+	MemoizeWithTTL(func(int) int { return 0 }, 0, 1)
+}
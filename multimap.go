@@ -0,0 +1,128 @@
+package godelin
+
+import (
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"slices"
+)
+
+// MultiMap keeps the values of all keys in one shared slab, so keys with only one or two
+// values, the common case, cost no allocation of their own.
+type MultiMap[K, V comparable] struct {
+	entries map[K]valueSpan
+	slab    valueSlab[V]
+}
+
+func NewMultiMap[K, V comparable]() *MultiMap[K, V] {
+	return &MultiMap[K, V]{entries: make(map[K]valueSpan)}
+}
+
+// MultiMapOf copies m, so url.Values and http.Header can be passed directly.
+func MultiMapOf[M ~map[K][]V, K, V comparable](m M) *MultiMap[K, V] {
+	result := &MultiMap[K, V]{entries: make(map[K]valueSpan, len(m))}
+	for key, values := range m {
+		result.Add(key, values...)
+	}
+	return result
+}
+
+func GroupByMultiMap[T any, K, V comparable](slice []T, transform func(T) (K, V)) *MultiMap[K, V] {
+	result := NewMultiMap[K, V]()
+	for _, element := range slice {
+		key, value := transform(element)
+		result.Add(key, value)
+	}
+	return result
+}
+
+func (m *MultiMap[K, V]) Add(key K, values ...V) {
+	if len(values) > 0 {
+		m.entries[key] = m.slab.appended(m.entries[key], values...)
+		compactSlab(&m.slab, m.entries)
+	}
+}
+
+// Get returns the values stored under key. Later changes to the MultiMap never show through
+// the result, and appending to it never modifies the MultiMap.
+func (m *MultiMap[K, V]) Get(key K) []V {
+	return EmptyIfNil(m.slab.view(m.entries[key]))
+}
+
+func (m *MultiMap[K, V]) ContainsKey(key K) bool {
+	_, exists := m.entries[key]
+	return exists
+}
+
+// RemoveValue removes the first occurrence of value under key and drops the key once it has no values left.
+func (m *MultiMap[K, V]) RemoveValue(key K, value V) bool {
+	span := m.entries[key]
+	index := slices.Index(m.slab.view(span), value)
+	if index < 0 {
+		return false
+	}
+	if span.length == 1 {
+		m.slab.release(span)
+		delete(m.entries, key)
+	} else {
+		m.entries[key] = m.slab.removed(span, index)
+	}
+	compactSlab(&m.slab, m.entries)
+	return true
+}
+
+func (m *MultiMap[K, V]) RemoveKey(key K) []V {
+	span := m.entries[key]
+	values := EmptyIfNil(m.slab.view(span))
+	m.slab.release(span)
+	delete(m.entries, key)
+	compactSlab(&m.slab, m.entries)
+	return values
+}
+
+func (m *MultiMap[K, V]) Len() int {
+	return len(m.entries)
+}
+
+func (m *MultiMap[K, V]) Size() int {
+	return FoldMapEntries(m.entries, 0, func(acc int, _ K, span valueSpan) int { return acc + int(span.length) })
+}
+
+func (m *MultiMap[K, V]) Keys() []K {
+	keys := make([]K, 0, len(m.entries))
+	for key := range m.entries {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func (m *MultiMap[K, V]) Flatten() []Pair[K, V] {
+	pairs := make([]Pair[K, V], 0, m.Size())
+	for key, span := range m.entries {
+		for _, value := range m.slab.view(span) {
+			pairs = append(pairs, Pair[K, V]{First: key, Second: value})
+		}
+	}
+	return pairs
+}
+
+func (m *MultiMap[K, V]) ToMap() map[K][]V {
+	result := make(map[K][]V, len(m.entries))
+	for key, span := range m.entries {
+		result[key] = slices.Clone(m.slab.view(span))
+	}
+	return result
+}
+
+func MultiMapToValues(m *MultiMap[string, string]) url.Values {
+	return m.ToMap()
+}
+
+func MultiMapToHeader(m *MultiMap[string, string]) http.Header {
+	header := make(http.Header, m.Len())
+	for key, span := range m.entries {
+		canonical := textproto.CanonicalMIMEHeaderKey(key)
+		header[canonical] = append(header[canonical], m.slab.view(span)...)
+	}
+	return header
+}
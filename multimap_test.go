@@ -0,0 +1,129 @@
+package godelin
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestMultiMapAddAndGet(t *testing.T) {
+	m := NewMultiMap[string, int]()
+	m.Add("a", 1)
+	m.Add("a", 2, 3)
+	m.Add("b")
+	if values := m.Get("a"); !reflect.DeepEqual(values, []int{1, 2, 3}) {
+		t.Errorf("Get() = %v, expected [1 2 3]", values)
+	}
+	if values := m.Get("b"); values == nil || len(values) != 0 || m.ContainsKey("b") {
+		t.Errorf("Add() without values created key b: %v", values)
+	}
+	if m.Len() != 1 || m.Size() != 3 {
+		t.Errorf("Len() = %d, Size() = %d, expected 1 and 3", m.Len(), m.Size())
+	}
+}
+
+func TestMultiMapGetDoesNotAlias(t *testing.T) {
+	m := NewMultiMap[string, int]()
+	m.Add("a", 1, 2)
+	m.Add("a", 3) // leaves spare capacity behind the stored slice
+	values := m.Get("a")
+	_ = append(values, 99)
+	m.Add("a", 4)
+	if actual := m.Get("a"); !reflect.DeepEqual(actual, []int{1, 2, 3, 4}) {
+		t.Errorf("Get() = %v, expected [1 2 3 4]", actual)
+	}
+}
+
+func TestMultiMapRemoveValueKeepsEarlierGetResults(t *testing.T) {
+	m := NewMultiMap[string, int]()
+	m.Add("k", 1, 2, 3)
+	values := m.Get("k")
+	m.RemoveValue("k", 1)
+	if !reflect.DeepEqual(values, []int{1, 2, 3}) {
+		t.Errorf("Get() result = %v after RemoveValue, expected [1 2 3]", values)
+	}
+	if actual := m.Get("k"); !reflect.DeepEqual(actual, []int{2, 3}) {
+		t.Errorf("Get() = %v, expected [2 3]", actual)
+	}
+}
+
+func TestMultiMapRemoveValue(t *testing.T) {
+	m := GroupByMultiMap([]string{"x1", "x2", "x1", "y1"}, func(s string) (string, string) {
+		return s[:1], s
+	})
+	if !m.RemoveValue("x", "x1") {
+		t.Errorf("RemoveValue() = false, expected true")
+	}
+	if values := m.Get("x"); !reflect.DeepEqual(values, []string{"x2", "x1"}) {
+		t.Errorf("Get() after RemoveValue = %v, expected [x2 x1]", values)
+	}
+	if m.RemoveValue("x", "x3") || m.RemoveValue("z", "z1") {
+		t.Errorf("RemoveValue() of a missing value = true, expected false")
+	}
+	m.RemoveValue("y", "y1")
+	if m.ContainsKey("y") {
+		t.Errorf("RemoveValue() kept a key without values")
+	}
+}
+
+func TestMultiMapRemoveKey(t *testing.T) {
+	m := NewMultiMap[string, int]()
+	m.Add("a", 1, 2)
+	if removed := m.RemoveKey("a"); !reflect.DeepEqual(removed, []int{1, 2}) || m.ContainsKey("a") {
+		t.Errorf("RemoveKey() = %v, expected [1 2] and the key to be gone", removed)
+	}
+	if removed := m.RemoveKey("a"); removed == nil || len(removed) != 0 {
+		t.Errorf("RemoveKey() of a missing key = %#v, expected an empty slice", removed)
+	}
+}
+
+func TestMultiMapKeysAndFlatten(t *testing.T) {
+	m := GroupByMultiMap([]int{1, 2, 3, 4}, func(n int) (bool, int) { return n%2 == 0, n })
+	keys := m.Keys()
+	sort.Slice(keys, func(i, j int) bool { return !keys[i] && keys[j] })
+	if !reflect.DeepEqual(keys, []bool{false, true}) {
+		t.Errorf("Keys() = %v, expected [false true]", keys)
+	}
+	flat := m.Flatten()
+	sort.Slice(flat, func(i, j int) bool { return flat[i].Second < flat[j].Second })
+	expected := []Pair[bool, int]{{false, 1}, {true, 2}, {false, 3}, {true, 4}}
+	if !reflect.DeepEqual(flat, expected) {
+		t.Errorf("Flatten() = %v, expected %v", flat, expected)
+	}
+}
+
+func TestMultiMapURLValuesRoundTrip(t *testing.T) {
+	query, _ := url.ParseQuery("tag=go&tag=kotlin&page=2")
+	m := MultiMapOf(query)
+	m.Add("tag", "rust")
+	if query.Encode() != "page=2&tag=go&tag=kotlin" {
+		t.Errorf("MultiMapOf() did not copy its input, query is now %q", query.Encode())
+	}
+	if encoded := MultiMapToValues(m).Encode(); encoded != "page=2&tag=go&tag=kotlin&tag=rust" {
+		t.Errorf("MultiMapToValues() = %q", encoded)
+	}
+}
+
+func TestMultiMapToHeaderCanonicalizesKeys(t *testing.T) {
+	m := MultiMapOf(http.Header{"Accept": {"text/html"}})
+	m.Add("accept", "application/json")
+	m.Add("x-request-id", "abc")
+	header := MultiMapToHeader(m)
+	accept := header.Values("Accept")
+	sort.Strings(accept)
+	if !reflect.DeepEqual(accept, []string{"application/json", "text/html"}) || header.Get("X-Request-Id") != "abc" {
+		t.Errorf("MultiMapToHeader() = %v", header)
+	}
+}
+
+func TestMultiMapToMapCopies(t *testing.T) {
+	m := NewMultiMap[string, int]()
+	m.Add("a", 1)
+	copied := m.ToMap()
+	copied["a"][0] = 42
+	if m.Get("a")[0] != 1 {
+		t.Errorf("ToMap() shares storage with the MultiMap")
+	}
+}
@@ -0,0 +1,483 @@
+package godelin
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+)
+
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+func MovingSum[T Number](slice []T, window int) []T {
+	if window <= 0 {
+		panic("MovingSum: window must be positive")
+	}
+	if len(slice) < window {
+		return []T{}
+	}
+	result := make([]T, 0, len(slice)-window+1)
+	var sum T
+	for i, element := range slice {
+		sum += element
+		if i >= window {
+			sum -= slice[i-window]
+		}
+		if i >= window-1 {
+			result = append(result, sum)
+		}
+	}
+	return result
+}
+
+func MovingAverage[T Number](slice []T, window int) []float64 {
+	if window <= 0 {
+		panic("MovingAverage: window must be positive")
+	}
+	return Map(MovingSum(slice, window), func(sum T) float64 {
+		return float64(sum) / float64(window)
+	})
+}
+
+func MovingMin[T cmp.Ordered](slice []T, window int) []T {
+	if window <= 0 {
+		panic("MovingMin: window must be positive")
+	}
+	return movingExtreme(slice, window, func(a, b T) bool { return a <= b })
+}
+
+func MovingMax[T cmp.Ordered](slice []T, window int) []T {
+	if window <= 0 {
+		panic("MovingMax: window must be positive")
+	}
+	return movingExtreme(slice, window, func(a, b T) bool { return a >= b })
+}
+
+// movingExtreme keeps a monotonic queue of indices whose values are ordered by dominates,
+// so every window's extreme is at the front and each element is pushed and popped once.
+func movingExtreme[T any](slice []T, window int, dominates func(T, T) bool) []T {
+	if len(slice) < window {
+		return []T{}
+	}
+	result := make([]T, 0, len(slice)-window+1)
+	queue := make([]int, 0, window)
+	for i, element := range slice {
+		if len(queue) > 0 && queue[0] <= i-window {
+			queue = queue[1:]
+		}
+		for len(queue) > 0 && dominates(element, slice[queue[len(queue)-1]]) {
+			queue = queue[:len(queue)-1]
+		}
+		queue = append(queue, i)
+		if i >= window-1 {
+			result = append(result, slice[queue[0]])
+		}
+	}
+	return result
+}
+
+// SplitByQuantiles sorts slice by key and cuts it into q buckets whose sizes differ by at
+// most one, lowest keys first. Equal keys may land in neighbouring buckets.
+func SplitByQuantiles[T any](slice []T, q int, key func(T) float64) [][]T {
+	if q <= 0 {
+		panic("SplitByQuantiles: q must be positive")
+	}
+	keyed := make([]Pair[float64, T], len(slice))
+	for i, element := range slice {
+		keyed[i] = Pair[float64, T]{First: key(element), Second: element}
+	}
+	slices.SortStableFunc(keyed, func(a, b Pair[float64, T]) int { return cmp.Compare(a.First, b.First) })
+	result := make([][]T, q)
+	for bucket := range q {
+		start, end := bucket*len(keyed)/q, (bucket+1)*len(keyed)/q
+		result[bucket] = Map(keyed[start:end], func(p Pair[float64, T]) T { return p.Second })
+	}
+	return result
+}
+
+// quantileSorted returns the q-quantile of sorted, interpolating linearly between the two
+// closest ranks.
+func quantileSorted(sorted []float64, q float64) float64 {
+	position := q * float64(len(sorted)-1)
+	lower := int(math.Floor(position))
+	upper := min(lower+1, len(sorted)-1)
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(position-float64(lower))
+}
+
+// OutlierIndicesIQR returns the indices of values outside [Q1 - k·IQR, Q3 + k·IQR]; k is
+// conventionally 1.5.
+func OutlierIndicesIQR(slice []float64, k float64) []int {
+	if len(slice) == 0 {
+		return []int{}
+	}
+	sorted := slices.Sorted(slices.Values(slice))
+	q1, q3 := quantileSorted(sorted, 0.25), quantileSorted(sorted, 0.75)
+	low, high := q1-k*(q3-q1), q3+k*(q3-q1)
+	indices := make([]int, 0)
+	for i, value := range slice {
+		if value < low || value > high {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+// FilterOutliersIQR returns slice without the values flagged by OutlierIndicesIQR, in their original order.
+func FilterOutliersIQR(slice []float64, k float64) []float64 {
+	outliers := OutlierIndicesIQR(slice, k)
+	return FilterIndexed(slice, func(i int, _ float64) bool {
+		_, found := slices.BinarySearch(outliers, i)
+		return !found
+	})
+}
+
+// ZScores returns how many population standard deviations each value lies from the mean.
+// All scores are zero when the values do not vary.
+func ZScores(slice []float64) []float64 {
+	if len(slice) == 0 {
+		return []float64{}
+	}
+	average := mean(slice)
+	deviation := math.Sqrt(covariance(slice, slice, average, average))
+	return Map(slice, func(value float64) float64 {
+		if deviation == 0 {
+			return 0
+		}
+		return (value - average) / deviation
+	})
+}
+
+// OutlierIndicesZ returns the indices of values whose z-score magnitude exceeds threshold.
+func OutlierIndicesZ(slice []float64, threshold float64) []int {
+	indices := make([]int, 0)
+	for i, score := range ZScores(slice) {
+		if math.Abs(score) > threshold {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+// ResampleLinear evaluates the piecewise-linear series through (xs[i], ys[i]) at each of
+// newXs. xs must be ascending; points outside its range take the value of the nearest end.
+func ResampleLinear(xs, ys []float64, newXs []float64) []float64 {
+	if len(xs) == 0 || len(xs) != len(ys) {
+		panic("ResampleLinear: xs and ys must be non-empty and of equal length")
+	}
+	return Map(newXs, func(x float64) float64 {
+		upper, _ := slices.BinarySearch(xs, x)
+		switch {
+		case upper == 0:
+			return ys[0]
+		case upper == len(xs):
+			return ys[len(ys)-1]
+		}
+		lower := upper - 1
+		return ys[lower] + (ys[upper]-ys[lower])*(x-xs[lower])/(xs[upper]-xs[lower])
+	})
+}
+
+// FillGapsForward returns a copy of slice with each NaN replaced by the closest preceding
+// value; leading NaNs stay NaN.
+func FillGapsForward(slice []float64) []float64 {
+	result := slices.Clone(EmptyIfNil(slice))
+	for i := 1; i < len(result); i++ {
+		if math.IsNaN(result[i]) {
+			result[i] = result[i-1]
+		}
+	}
+	return result
+}
+
+// FillGapsBackward returns a copy of slice with each NaN replaced by the closest following
+// value; trailing NaNs stay NaN.
+func FillGapsBackward(slice []float64) []float64 {
+	result := slices.Clone(EmptyIfNil(slice))
+	for i := len(result) - 2; i >= 0; i-- {
+		if math.IsNaN(result[i]) {
+			result[i] = result[i+1]
+		}
+	}
+	return result
+}
+
+var (
+	ErrLengthMismatch = errors.New("slices differ in length")
+	ErrEmptyInput     = errors.New("empty input")
+	ErrZeroVariance   = errors.New("zero variance")
+)
+
+func mean(slice []float64) float64 {
+	return Fold(slice, 0.0, func(acc, value float64) float64 { return acc + value }) / float64(len(slice))
+}
+
+func covariance(a, b []float64, meanA, meanB float64) float64 {
+	return FoldIndexed(a, 0.0, func(i int, acc, value float64) float64 {
+		return acc + (value-meanA)*(b[i]-meanB)
+	}) / float64(len(a))
+}
+
+func checkPaired(a, b []float64) error {
+	if len(a) != len(b) {
+		return fmt.Errorf("%w: %d and %d", ErrLengthMismatch, len(a), len(b))
+	}
+	if len(a) == 0 {
+		return ErrEmptyInput
+	}
+	return nil
+}
+
+// Covariance returns the population covariance of the paired values in a and b.
+func Covariance(a, b []float64) (float64, error) {
+	if err := checkPaired(a, b); err != nil {
+		return 0, err
+	}
+	return covariance(a, b, mean(a), mean(b)), nil
+}
+
+// PearsonCorrelation returns the linear correlation of a and b, between -1 and 1. It fails with
+// ErrZeroVariance when either slice is constant, since the correlation is then undefined.
+func PearsonCorrelation(a, b []float64) (float64, error) {
+	if err := checkPaired(a, b); err != nil {
+		return 0, err
+	}
+	meanA, meanB := mean(a), mean(b)
+	varianceA, varianceB := covariance(a, a, meanA, meanA), covariance(b, b, meanB, meanB)
+	if varianceA == 0 || varianceB == 0 {
+		return 0, ErrZeroVariance
+	}
+	return covariance(a, b, meanA, meanB) / math.Sqrt(varianceA*varianceB), nil
+}
+
+// Lag returns a copy of slice shifted n positions later: result[i] is slice[i-n], and the
+// first n positions hold fill.
+func Lag[T any](slice []T, n int, fill T) []T {
+	if n < 0 {
+		panic("Lag: n must not be negative")
+	}
+	result := make([]T, len(slice))
+	for i := range result {
+		if i >= n {
+			result[i] = slice[i-n]
+		} else {
+			result[i] = fill
+		}
+	}
+	return result
+}
+
+// Lead returns a copy of slice shifted n positions earlier: result[i] is slice[i+n], and the
+// last n positions hold fill.
+func Lead[T any](slice []T, n int, fill T) []T {
+	if n < 0 {
+		panic("Lead: n must not be negative")
+	}
+	result := make([]T, len(slice))
+	for i := range result {
+		if i+n < len(slice) {
+			result[i] = slice[i+n]
+		} else {
+			result[i] = fill
+		}
+	}
+	return result
+}
+
+// DiffN returns slice[i] - slice[i-n] for every i >= n, so the result is n elements shorter.
+func DiffN[T Number](slice []T, n int) []T {
+	if n <= 0 {
+		panic("DiffN: n must be positive")
+	}
+	if len(slice) <= n {
+		return []T{}
+	}
+	result := make([]T, len(slice)-n)
+	for i := range result {
+		result[i] = slice[i+n] - slice[i]
+	}
+	return result
+}
+
+// Percentile returns the p-th percentile (p within [0, 100]) of slice, interpolating linearly
+// between the closest ranks, or NaN for an empty slice. It uses quickselect on a copy, so it
+// runs in expected linear time and leaves slice untouched.
+func Percentile[T Number](slice []T, p float64) float64 {
+	if p < 0 || p > 100 {
+		panic("Percentile: p must be within [0, 100]")
+	}
+	if len(slice) == 0 {
+		return math.NaN()
+	}
+	values := Map(slice, func(value T) float64 { return float64(value) })
+	position := p / 100 * float64(len(values)-1)
+	lower := int(math.Floor(position))
+	lowerValue := selectKth(values, lower)
+	if lower == len(values)-1 {
+		return lowerValue
+	}
+	// selectKth leaves only values >= values[lower] after it, so the next rank is their minimum.
+	upperValue := slices.Min(values[lower+1:])
+	return lowerValue + (upperValue-lowerValue)*(position-float64(lower))
+}
+
+// selectKth partially reorders values so that values[k] is the k-th smallest, with smaller or
+// equal values before it and greater or equal values after it, and returns it.
+func selectKth(values []float64, k int) float64 {
+	low, high := 0, len(values)-1
+	for low < high {
+		a, b, c := values[low], values[low+(high-low)/2], values[high]
+		pivot := max(min(a, b), min(max(a, b), c))
+		// Three-way partition, so runs of equal values do not degrade to quadratic time.
+		less, i, greater := low, low, high
+		for i <= greater {
+			switch {
+			case values[i] < pivot:
+				values[less], values[i] = values[i], values[less]
+				less++
+				i++
+			case values[i] > pivot:
+				values[i], values[greater] = values[greater], values[i]
+				greater--
+			default:
+				i++
+			}
+		}
+		switch {
+		case k < less:
+			high = less - 1
+		case k > greater:
+			low = greater + 1
+		default:
+			return pivot
+		}
+	}
+	return values[k]
+}
+
+func Median[T Number](slice []T) float64 {
+	return Percentile(slice, 50)
+}
+
+// Mode returns the most frequent element, preferring the one that appears first on ties.
+func Mode[T comparable](slice []T) (T, bool) {
+	counts := make(map[T]int, len(slice))
+	best := 0
+	for _, element := range slice {
+		counts[element]++
+		best = max(best, counts[element])
+	}
+	for _, element := range slice {
+		if counts[element] == best {
+			return element, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// Variance returns the population variance of slice, or NaN for an empty slice.
+func Variance[T Number](slice []T) float64 {
+	if len(slice) == 0 {
+		return math.NaN()
+	}
+	values := Map(slice, func(value T) float64 { return float64(value) })
+	average := mean(values)
+	return covariance(values, values, average, average)
+}
+
+func StdDev[T Number](slice []T) float64 {
+	return math.Sqrt(Variance(slice))
+}
+
+// MinMax finds both extremes in a single pass.
+func MinMax[T cmp.Ordered](slice []T) (minimum, maximum T, ok bool) {
+	if len(slice) == 0 {
+		return minimum, maximum, false
+	}
+	minimum, maximum = slice[0], slice[0]
+	for _, element := range slice[1:] {
+		minimum = min(minimum, element)
+		maximum = max(maximum, element)
+	}
+	return minimum, maximum, true
+}
+
+// Bucketize groups elements into len(boundaries)+1 buckets by value: bucket 0 holds values
+// below boundaries[0], bucket i holds values in [boundaries[i-1], boundaries[i]), and the last
+// bucket holds values at or above the last boundary. Boundaries must be strictly ascending.
+func Bucketize[T any, B cmp.Ordered](slice []T, boundaries []B, value func(T) B) [][]T {
+	checkBoundaries(boundaries, "Bucketize")
+	buckets := make([][]T, len(boundaries)+1)
+	for i := range buckets {
+		buckets[i] = []T{}
+	}
+	for _, element := range slice {
+		i := bucketIndex(boundaries, value(element))
+		buckets[i] = append(buckets[i], element)
+	}
+	return buckets
+}
+
+// BucketCounts counts the elements Bucketize would put in each bucket without collecting them.
+func BucketCounts[T any, B cmp.Ordered](slice []T, boundaries []B, value func(T) B) []int {
+	checkBoundaries(boundaries, "BucketCounts")
+	counts := make([]int, len(boundaries)+1)
+	for _, element := range slice {
+		counts[bucketIndex(boundaries, value(element))]++
+	}
+	return counts
+}
+
+func checkBoundaries[B cmp.Ordered](boundaries []B, function string) {
+	for i := 1; i < len(boundaries); i++ {
+		if boundaries[i-1] >= boundaries[i] {
+			panic(function + ": boundaries must be strictly ascending")
+		}
+	}
+}
+
+func bucketIndex[B cmp.Ordered](boundaries []B, value B) int {
+	index, found := slices.BinarySearch(boundaries, value)
+	if found {
+		index++
+	}
+	return index
+}
+
+// AddMaps returns the key-wise sum of a and b; a key missing from one map counts as zero.
+func AddMaps[M ~map[K]N, K comparable, N Number](a, b M) M {
+	result := make(M, max(len(a), len(b)))
+	for key, value := range a {
+		result[key] = value
+	}
+	for key, value := range b {
+		result[key] += value
+	}
+	return result
+}
+
+// SubtractMaps returns the key-wise difference a - b; a key missing from one map counts as
+// zero, and keys whose difference is zero are kept.
+func SubtractMaps[M ~map[K]N, K comparable, N Number](a, b M) M {
+	result := make(M, max(len(a), len(b)))
+	for key, value := range a {
+		result[key] = value
+	}
+	for key, value := range b {
+		result[key] -= value
+	}
+	return result
+}
+
+func ScaleMap[M ~map[K]N, K comparable, N Number](m M, factor N) M {
+	result := make(M, len(m))
+	for key, value := range m {
+		result[key] = value * factor
+	}
+	return result
+}
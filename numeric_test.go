@@ -0,0 +1,415 @@
+package godelin
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestMovingSum(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    []int
+		window   int
+		expected []int
+	}{
+		{name: "window of 3", input: []int{1, 2, 3, 4, 5}, window: 3, expected: []int{6, 9, 12}},
+		{name: "window of 1", input: []int{4, 5}, window: 1, expected: []int{4, 5}},
+		{name: "window equals length", input: []int{1, 2, 3}, window: 3, expected: []int{6}},
+		{name: "window longer than input", input: []int{1, 2}, window: 3, expected: []int{}},
+		{name: "empty slice", input: []int{}, window: 2, expected: []int{}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			actual := MovingSum(testCase.input, testCase.window)
+			if !reflect.DeepEqual(actual, testCase.expected) {
+				t.Errorf("MovingSum() = %v, expected %v", actual, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestMovingAverage(t *testing.T) {
+	actual := MovingAverage([]int{1, 2, 3, 4}, 2)
+	expected := []float64{1.5, 2.5, 3.5}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("MovingAverage() = %v, expected %v", actual, expected)
+	}
+}
+
+func TestMovingMinMax(t *testing.T) {
+	input := []int{4, 2, 12, 3, 8, 1, 7, 7}
+	testCases := []struct {
+		name     string
+		fn       func([]int, int) []int
+		window   int
+		expected []int
+	}{
+		{name: "min window 3", fn: MovingMin[int], window: 3, expected: []int{2, 2, 3, 1, 1, 1}},
+		{name: "max window 3", fn: MovingMax[int], window: 3, expected: []int{12, 12, 12, 8, 8, 7}},
+		{name: "min window 1", fn: MovingMin[int], window: 1, expected: input},
+		{name: "max whole slice", fn: MovingMax[int], window: len(input), expected: []int{12}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			actual := testCase.fn(input, testCase.window)
+			if !reflect.DeepEqual(actual, testCase.expected) {
+				t.Errorf("%s = %v, expected %v", testCase.name, actual, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestMovingMaxMatchesWindowed(t *testing.T) {
+	input := []int{5, 1, 9, 9, 2, 6, 3, 8, 7, 4, 0, 6}
+	for window := 1; window <= len(input); window++ {
+		full := Filter(Windowed(input, window, 1), func(w []int) bool { return len(w) == window })
+		expected := Map(full, func(w []int) int { return slices.Max(w) })
+		if actual := MovingMax(input, window); !reflect.DeepEqual(actual, expected) {
+			t.Errorf("MovingMax(window=%d) = %v, expected %v", window, actual, expected)
+		}
+	}
+}
+
+func TestMovingPanicsOnInvalidWindow(t *testing.T) {
+	for name, fn := range map[string]func(){
+		"MovingSum":     func() { MovingSum([]int{1}, 0) },
+		"MovingAverage": func() { MovingAverage([]int{1}, -1) },
+		"MovingMin":     func() { MovingMin([]int{1}, 0) },
+		"MovingMax":     func() { MovingMax([]int{1}, 0) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("expected a panic but did not get one")
+				}
+			}()
+			fn()
+		})
+	}
+}
+
+func TestSplitByQuantiles(t *testing.T) {
+	identity := func(n int) float64 { return float64(n) }
+	testCases := []struct {
+		name     string
+		slice    []int
+		q        int
+		expected [][]int
+	}{
+		{"even split", []int{8, 1, 6, 3, 4, 5, 2, 7}, 4, [][]int{{1, 2}, {3, 4}, {5, 6}, {7, 8}}},
+		{"uneven split", []int{5, 4, 3, 2, 1}, 2, [][]int{{1, 2}, {3, 4, 5}}},
+		{"more buckets than elements", []int{2, 1}, 3, [][]int{{}, {1}, {2}}},
+		{"empty", []int{}, 2, [][]int{{}, {}}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := SplitByQuantiles(tc.slice, tc.q, identity); !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("SplitByQuantiles(%v, %d) = %v, expected %v", tc.slice, tc.q, got, tc.expected)
+			}
+		})
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected a panic but did not get one")
+		}
+	}()
+	SplitByQuantiles([]int{1}, 0, identity)
+}
+
+func TestOutliersIQR(t *testing.T) {
+	data := []float64{5, 100, 1, 2, 3, 4, -50, 6, 7, 8, 9}
+	if got, expected := OutlierIndicesIQR(data, 1.5), []int{1, 6}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("OutlierIndicesIQR() = %v, expected %v", got, expected)
+	}
+	if got, expected := FilterOutliersIQR(data, 1.5), []float64{5, 1, 2, 3, 4, 6, 7, 8, 9}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("FilterOutliersIQR() = %v, expected %v", got, expected)
+	}
+	if got := FilterOutliersIQR([]float64{}, 1.5); !reflect.DeepEqual(got, []float64{}) {
+		t.Errorf("FilterOutliersIQR([]) = %v, expected []", got)
+	}
+}
+
+func TestZScores(t *testing.T) {
+	testCases := []struct {
+		name     string
+		slice    []float64
+		expected []float64
+	}{
+		{"varied", []float64{2, 4, 4, 4, 5, 5, 7, 9}, []float64{-1.5, -0.5, -0.5, -0.5, 0, 0, 1, 2}},
+		{"constant", []float64{3, 3}, []float64{0, 0}},
+		{"empty", nil, []float64{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := ZScores(tc.slice); !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("ZScores(%v) = %v, expected %v", tc.slice, got, tc.expected)
+			}
+		})
+	}
+	if got, expected := OutlierIndicesZ([]float64{2, 4, 4, 4, 5, 5, 7, 9}, 1.9), []int{7}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("OutlierIndicesZ() = %v, expected %v", got, expected)
+	}
+}
+
+func TestResampleLinear(t *testing.T) {
+	xs := []float64{0, 10, 20}
+	ys := []float64{0, 100, 50}
+	got := ResampleLinear(xs, ys, []float64{-5, 0, 2.5, 10, 15, 20, 30})
+	if expected := []float64{0, 0, 25, 100, 75, 50, 50}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("ResampleLinear() = %v, expected %v", got, expected)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected a panic but did not get one")
+		}
+	}()
+	ResampleLinear([]float64{1, 2}, []float64{1}, []float64{1})
+}
+
+func TestFillGaps(t *testing.T) {
+	nan := math.NaN()
+	input := []float64{nan, 1, nan, nan, 4, nan}
+	forward := FillGapsForward(input)
+	backward := FillGapsBackward(input)
+	expectedForward := []float64{nan, 1, 1, 1, 4, 4}
+	expectedBackward := []float64{1, 1, 4, 4, 4, nan}
+	equal := func(a, b []float64) bool {
+		return slices.EqualFunc(a, b, func(x, y float64) bool { return x == y || math.IsNaN(x) && math.IsNaN(y) })
+	}
+	if !equal(forward, expectedForward) {
+		t.Errorf("FillGapsForward() = %v, expected %v", forward, expectedForward)
+	}
+	if !equal(backward, expectedBackward) {
+		t.Errorf("FillGapsBackward() = %v, expected %v", backward, expectedBackward)
+	}
+	if !math.IsNaN(input[2]) {
+		t.Errorf("FillGapsForward() modified its input: %v", input)
+	}
+	if got := FillGapsForward(nil); !reflect.DeepEqual(got, []float64{}) {
+		t.Errorf("FillGapsForward(nil) = %#v, expected []float64{}", got)
+	}
+}
+
+func TestCovarianceAndCorrelation(t *testing.T) {
+	testCases := []struct {
+		name                string
+		a, b                []float64
+		expectedCovariance  float64
+		expectedCorrelation float64
+	}{
+		{"perfect positive", []float64{1, 2, 3, 4}, []float64{2, 4, 6, 8}, 2.5, 1},
+		{"perfect negative", []float64{1, 2, 3}, []float64{3, 2, 1}, -2.0 / 3, -1},
+		{"unrelated", []float64{1, 2, 3, 4}, []float64{1, -1, -1, 1}, 0, 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			covariance, err := Covariance(tc.a, tc.b)
+			if err != nil || math.Abs(covariance-tc.expectedCovariance) > 1e-9 {
+				t.Errorf("Covariance() = %v, %v, expected %v", covariance, err, tc.expectedCovariance)
+			}
+			correlation, err := PearsonCorrelation(tc.a, tc.b)
+			if err != nil || math.Abs(correlation-tc.expectedCorrelation) > 1e-9 {
+				t.Errorf("PearsonCorrelation() = %v, %v, expected %v", correlation, err, tc.expectedCorrelation)
+			}
+		})
+	}
+}
+
+func TestCorrelationErrors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		a, b     []float64
+		expected error
+	}{
+		{"length mismatch", []float64{1, 2}, []float64{1}, ErrLengthMismatch},
+		{"empty", []float64{}, nil, ErrEmptyInput},
+		{"constant", []float64{1, 2, 3}, []float64{5, 5, 5}, ErrZeroVariance},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := PearsonCorrelation(tc.a, tc.b); !errors.Is(err, tc.expected) {
+				t.Errorf("PearsonCorrelation() error = %v, expected %v", err, tc.expected)
+			}
+		})
+	}
+	if _, err := Covariance([]float64{1}, []float64{1, 2}); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("Covariance() error = %v, expected %v", err, ErrLengthMismatch)
+	}
+}
+
+func TestLagLead(t *testing.T) {
+	input := []int{1, 2, 3, 4}
+	testCases := []struct {
+		name     string
+		fn       func([]int, int, int) []int
+		n        int
+		expected []int
+	}{
+		{"Lag 1", Lag[int], 1, []int{0, 1, 2, 3}},
+		{"Lag 0", Lag[int], 0, []int{1, 2, 3, 4}},
+		{"Lag beyond length", Lag[int], 6, []int{0, 0, 0, 0}},
+		{"Lead 2", Lead[int], 2, []int{3, 4, 0, 0}},
+		{"Lead beyond length", Lead[int], 5, []int{0, 0, 0, 0}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.fn(input, tc.n, 0); !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("%s = %v, expected %v", tc.name, got, tc.expected)
+			}
+		})
+	}
+	if got := Lag([]string(nil), 1, "-"); !reflect.DeepEqual(got, []string{}) {
+		t.Errorf("Lag(nil) = %#v, expected []string{}", got)
+	}
+}
+
+func TestDiffN(t *testing.T) {
+	testCases := []struct {
+		name     string
+		slice    []float64
+		n        int
+		expected []float64
+	}{
+		{"first difference", []float64{1, 4, 9, 16}, 1, []float64{3, 5, 7}},
+		{"period of two", []float64{10, 20, 15, 30}, 2, []float64{5, 10}},
+		{"too short", []float64{1, 2}, 2, []float64{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := DiffN(tc.slice, tc.n); !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("DiffN(%v, %d) = %v, expected %v", tc.slice, tc.n, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestPercentileMatchesSortedQuantile(t *testing.T) {
+	rng := rand.New(rand.NewPCG(6, 6))
+	for range 200 {
+		values := Iterate(1+rng.IntN(50), func(int) float64 { return float64(rng.IntN(10)) })
+		original := slices.Clone(values)
+		sorted := slices.Sorted(slices.Values(values))
+		for _, p := range []float64{0, 10, 25, 50, 90, 99, 100} {
+			if got, expected := Percentile(values, p), quantileSorted(sorted, p/100); math.Abs(got-expected) > 1e-9 {
+				t.Fatalf("Percentile(%v, %v) = %v, expected %v", values, p, got, expected)
+			}
+		}
+		if !reflect.DeepEqual(values, original) {
+			t.Fatalf("Percentile() reordered its input")
+		}
+	}
+}
+
+func TestStatistics(t *testing.T) {
+	data := []int{2, 4, 4, 4, 5, 5, 7, 9}
+	if got := Median(data); got != 4.5 {
+		t.Errorf("Median() = %v, expected 4.5", got)
+	}
+	if got := Median([]int{3, 1, 2}); got != 2 {
+		t.Errorf("Median([3 1 2]) = %v, expected 2", got)
+	}
+	if got := Variance(data); got != 4 {
+		t.Errorf("Variance() = %v, expected 4", got)
+	}
+	if got := StdDev(data); got != 2 {
+		t.Errorf("StdDev() = %v, expected 2", got)
+	}
+	if got := Percentile(data, 25); got != 4 {
+		t.Errorf("Percentile(25) = %v, expected 4", got)
+	}
+	for name, value := range map[string]float64{"Median": Median([]int{}), "Variance": Variance([]float64{}), "Percentile": Percentile([]int{}, 50)} {
+		if !math.IsNaN(value) {
+			t.Errorf("%s of empty slice = %v, expected NaN", name, value)
+		}
+	}
+}
+
+func TestMode(t *testing.T) {
+	testCases := []struct {
+		name          string
+		slice         []string
+		expected      string
+		expectedFound bool
+	}{
+		{"clear winner", []string{"a", "b", "b", "c"}, "b", true},
+		{"tie prefers first", []string{"c", "a", "a", "c"}, "c", true},
+		{"empty", nil, "", false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got, found := Mode(tc.slice); got != tc.expected || found != tc.expectedFound {
+				t.Errorf("Mode(%v) = %q, %v, expected %q, %v", tc.slice, got, found, tc.expected, tc.expectedFound)
+			}
+		})
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	if low, high, ok := MinMax([]int{3, -1, 7, 2}); low != -1 || high != 7 || !ok {
+		t.Errorf("MinMax() = %v, %v, %v, expected -1, 7, true", low, high, ok)
+	}
+	if low, high, ok := MinMax([]string{}); low != "" || high != "" || ok {
+		t.Errorf("MinMax([]) = %q, %q, %v, expected zero values and false", low, high, ok)
+	}
+}
+
+func TestBucketize(t *testing.T) {
+	latencies := []time.Duration{5 * time.Millisecond, 10 * time.Millisecond, 99 * time.Millisecond, 250 * time.Millisecond, time.Second}
+	boundaries := []time.Duration{10 * time.Millisecond, 100 * time.Millisecond, 500 * time.Millisecond}
+	self := func(d time.Duration) time.Duration { return d }
+	expected := [][]time.Duration{
+		{5 * time.Millisecond},
+		{10 * time.Millisecond, 99 * time.Millisecond},
+		{250 * time.Millisecond},
+		{time.Second},
+	}
+	if got := Bucketize(latencies, boundaries, self); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Bucketize() = %v, expected %v", got, expected)
+	}
+	if got := BucketCounts(latencies, boundaries, self); !reflect.DeepEqual(got, []int{1, 2, 1, 1}) {
+		t.Errorf("BucketCounts() = %v, expected [1 2 1 1]", got)
+	}
+	if got := Bucketize([]int{}, []int{5}, func(n int) int { return n }); !reflect.DeepEqual(got, [][]int{{}, {}}) {
+		t.Errorf("Bucketize([]) = %v, expected [[] []]", got)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected a panic but did not get one")
+		}
+	}()
+	BucketCounts([]int{1}, []int{5, 5}, func(n int) int { return n })
+}
+
+func TestMapArithmetic(t *testing.T) {
+	shard1 := map[string]int{"go": 3, "rust": 1}
+	shard2 := map[string]int{"go": 2, "zig": 4}
+
+	if got, expected := AddMaps(shard1, shard2), map[string]int{"go": 5, "rust": 1, "zig": 4}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("AddMaps() = %v, expected %v", got, expected)
+	}
+	if got, expected := SubtractMaps(shard1, shard2), map[string]int{"go": 1, "rust": 1, "zig": -4}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("SubtractMaps() = %v, expected %v", got, expected)
+	}
+	if got, expected := SubtractMaps(shard1, shard1), map[string]int{"go": 0, "rust": 0}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("SubtractMaps(self) = %v, expected %v", got, expected)
+	}
+	if got, expected := ScaleMap(map[string]float64{"a": 1.5, "b": -2}, 2), map[string]float64{"a": 3, "b": -4}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("ScaleMap() = %v, expected %v", got, expected)
+	}
+	if got := AddMaps[map[string]int](nil, nil); got == nil || len(got) != 0 {
+		t.Errorf("AddMaps(nil, nil) = %#v, expected an empty map", got)
+	}
+	if shard1["go"] != 3 || len(shard1) != 2 {
+		t.Errorf("map arithmetic modified its input: %v", shard1)
+	}
+}
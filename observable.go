@@ -0,0 +1,150 @@
+package godelin
+
+import (
+	"maps"
+	"slices"
+)
+
+type ChangeKind int
+
+const (
+	ChangeAdd ChangeKind = iota
+	ChangeRemove
+	ChangeUpdate
+)
+
+type SliceChange[T any] struct {
+	Kind  ChangeKind
+	Index int
+	Old   T
+	New   T
+}
+
+type MapChange[K comparable, V any] struct {
+	Kind ChangeKind
+	Key  K
+	Old  V
+	New  V
+}
+
+type observers[E any] struct {
+	nextID   int
+	handlers []Pair[int, func(E)]
+}
+
+func (o *observers[E]) subscribe(handler func(E)) func() {
+	id := o.nextID
+	o.nextID++
+	o.handlers = append(o.handlers, Pair[int, func(E)]{First: id, Second: handler})
+	return func() {
+		o.handlers = slices.DeleteFunc(o.handlers, func(entry Pair[int, func(E)]) bool {
+			return entry.First == id
+		})
+	}
+}
+
+func (o *observers[E]) notify(event E) {
+	for _, entry := range slices.Clone(o.handlers) {
+		entry.Second(event)
+	}
+}
+
+// ObservableSlice notifies subscribers synchronously after every mutation.
+// Like a plain slice, it is not safe for concurrent use.
+type ObservableSlice[T any] struct {
+	elements  []T
+	observers observers[SliceChange[T]]
+}
+
+func NewObservableSlice[T any](elements ...T) *ObservableSlice[T] {
+	return &ObservableSlice[T]{elements: slices.Clone(elements)}
+}
+
+func (s *ObservableSlice[T]) Subscribe(handler func(SliceChange[T])) (unsubscribe func()) {
+	return s.observers.subscribe(handler)
+}
+
+func (s *ObservableSlice[T]) Len() int {
+	return len(s.elements)
+}
+
+func (s *ObservableSlice[T]) Get(index int) T {
+	return s.elements[index]
+}
+
+func (s *ObservableSlice[T]) Snapshot() []T {
+	return Plus(s.elements)
+}
+
+func (s *ObservableSlice[T]) Append(values ...T) {
+	for _, value := range values {
+		s.elements = append(s.elements, value)
+		s.observers.notify(SliceChange[T]{Kind: ChangeAdd, Index: len(s.elements) - 1, New: value})
+	}
+}
+
+func (s *ObservableSlice[T]) Insert(index int, value T) {
+	s.elements = slices.Insert(s.elements, index, value)
+	s.observers.notify(SliceChange[T]{Kind: ChangeAdd, Index: index, New: value})
+}
+
+func (s *ObservableSlice[T]) Set(index int, value T) {
+	old := s.elements[index]
+	s.elements[index] = value
+	s.observers.notify(SliceChange[T]{Kind: ChangeUpdate, Index: index, Old: old, New: value})
+}
+
+func (s *ObservableSlice[T]) RemoveAt(index int) T {
+	old := s.elements[index]
+	s.elements = slices.Delete(s.elements, index, index+1)
+	s.observers.notify(SliceChange[T]{Kind: ChangeRemove, Index: index, Old: old})
+	return old
+}
+
+// ObservableMap notifies subscribers synchronously after every mutation.
+// Like a plain map, it is not safe for concurrent use.
+type ObservableMap[K comparable, V any] struct {
+	entries   map[K]V
+	observers observers[MapChange[K, V]]
+}
+
+func NewObservableMap[K comparable, V any]() *ObservableMap[K, V] {
+	return &ObservableMap[K, V]{entries: make(map[K]V)}
+}
+
+func (m *ObservableMap[K, V]) Subscribe(handler func(MapChange[K, V])) (unsubscribe func()) {
+	return m.observers.subscribe(handler)
+}
+
+func (m *ObservableMap[K, V]) Len() int {
+	return len(m.entries)
+}
+
+func (m *ObservableMap[K, V]) Get(key K) (V, bool) {
+	value, exists := m.entries[key]
+	return value, exists
+}
+
+func (m *ObservableMap[K, V]) Snapshot() map[K]V {
+	return maps.Clone(m.entries)
+}
+
+func (m *ObservableMap[K, V]) Set(key K, value V) {
+	old, exists := m.entries[key]
+	m.entries[key] = value
+	kind := ChangeAdd
+	if exists {
+		kind = ChangeUpdate
+	}
+	m.observers.notify(MapChange[K, V]{Kind: kind, Key: key, Old: old, New: value})
+}
+
+func (m *ObservableMap[K, V]) Delete(key K) bool {
+	old, exists := m.entries[key]
+	if !exists {
+		return false
+	}
+	delete(m.entries, key)
+	m.observers.notify(MapChange[K, V]{Kind: ChangeRemove, Key: key, Old: old})
+	return true
+}
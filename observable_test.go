@@ -0,0 +1,94 @@
+package godelin
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestObservableSliceEmitsChanges(t *testing.T) {
+	s := NewObservableSlice("a")
+	var events []SliceChange[string]
+	s.Subscribe(func(change SliceChange[string]) { events = append(events, change) })
+
+	s.Append("b", "c")
+	s.Set(0, "A")
+	s.Insert(1, "x")
+	removed := s.RemoveAt(2)
+
+	expected := []SliceChange[string]{
+		{Kind: ChangeAdd, Index: 1, New: "b"},
+		{Kind: ChangeAdd, Index: 2, New: "c"},
+		{Kind: ChangeUpdate, Index: 0, Old: "a", New: "A"},
+		{Kind: ChangeAdd, Index: 1, New: "x"},
+		{Kind: ChangeRemove, Index: 2, Old: "b"},
+	}
+	if !reflect.DeepEqual(events, expected) {
+		t.Errorf("events = %+v, expected %+v", events, expected)
+	}
+	if removed != "b" || !reflect.DeepEqual(s.Snapshot(), []string{"A", "x", "c"}) || s.Len() != 3 || s.Get(1) != "x" {
+		t.Errorf("Snapshot() = %v after removing %q", s.Snapshot(), removed)
+	}
+}
+
+func TestObservableSliceSnapshotIsACopy(t *testing.T) {
+	initial := []int{1, 2}
+	s := NewObservableSlice(initial...)
+	initial[0] = 100
+	s.Snapshot()[1] = 200
+	if !reflect.DeepEqual(s.Snapshot(), []int{1, 2}) {
+		t.Errorf("ObservableSlice shares storage with callers: %v", s.Snapshot())
+	}
+}
+
+func TestObservableMapEmitsChanges(t *testing.T) {
+	m := NewObservableMap[string, int]()
+	var events []MapChange[string, int]
+	m.Subscribe(func(change MapChange[string, int]) { events = append(events, change) })
+
+	m.Set("a", 1)
+	m.Set("a", 2)
+	m.Delete("a")
+	m.Delete("missing")
+
+	expected := []MapChange[string, int]{
+		{Kind: ChangeAdd, Key: "a", New: 1},
+		{Kind: ChangeUpdate, Key: "a", Old: 1, New: 2},
+		{Kind: ChangeRemove, Key: "a", Old: 2},
+	}
+	if !reflect.DeepEqual(events, expected) {
+		t.Errorf("events = %+v, expected %+v", events, expected)
+	}
+}
+
+func TestObservableUnsubscribe(t *testing.T) {
+	m := NewObservableMap[string, int]()
+	var first, second int
+	unsubscribeFirst := m.Subscribe(func(MapChange[string, int]) { first++ })
+	m.Subscribe(func(MapChange[string, int]) { second++ })
+
+	m.Set("a", 1)
+	unsubscribeFirst()
+	unsubscribeFirst()
+	m.Set("b", 2)
+
+	if first != 1 || second != 2 {
+		t.Errorf("handler calls = %d and %d, expected 1 and 2", first, second)
+	}
+	if value, found := m.Get("b"); value != 2 || !found || m.Len() != 2 || len(m.Snapshot()) != 2 {
+		t.Errorf("map state is wrong: %v", m.Snapshot())
+	}
+}
+
+func TestObservableUnsubscribeDuringNotification(t *testing.T) {
+	s := NewObservableSlice[int]()
+	calls := 0
+	var unsubscribe func()
+	unsubscribe = s.Subscribe(func(SliceChange[int]) {
+		calls++
+		unsubscribe()
+	})
+	s.Append(1, 2)
+	if calls != 1 {
+		t.Errorf("handler called %d times, expected 1", calls)
+	}
+}
@@ -0,0 +1,120 @@
+package godelin
+
+import "iter"
+
+type orderedEntry[K comparable, V any] struct {
+	key   K
+	value V
+	prev  *orderedEntry[K, V]
+	next  *orderedEntry[K, V]
+}
+
+type OrderedMap[K comparable, V any] struct {
+	entries map[K]*orderedEntry[K, V]
+	head    *orderedEntry[K, V]
+	tail    *orderedEntry[K, V]
+}
+
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{entries: make(map[K]*orderedEntry[K, V])}
+}
+
+func (m *OrderedMap[K, V]) Len() int {
+	return len(m.entries)
+}
+
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	if entry, exists := m.entries[key]; exists {
+		return entry.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Set updates the value in place when the key exists, keeping its original position.
+func (m *OrderedMap[K, V]) Set(key K, value V) {
+	if entry, exists := m.entries[key]; exists {
+		entry.value = value
+		return
+	}
+	entry := &orderedEntry[K, V]{key: key, value: value, prev: m.tail}
+	if m.tail == nil {
+		m.head = entry
+	} else {
+		m.tail.next = entry
+	}
+	m.tail = entry
+	m.entries[key] = entry
+}
+
+func (m *OrderedMap[K, V]) GetOrPut(key K, defaultValue func(K) V) V {
+	if entry, exists := m.entries[key]; exists {
+		return entry.value
+	}
+	newValue := defaultValue(key)
+	m.Set(key, newValue)
+	return newValue
+}
+
+func (m *OrderedMap[K, V]) Delete(key K) bool {
+	entry, exists := m.entries[key]
+	if !exists {
+		return false
+	}
+	if entry.prev == nil {
+		m.head = entry.next
+	} else {
+		entry.prev.next = entry.next
+	}
+	if entry.next == nil {
+		m.tail = entry.prev
+	} else {
+		entry.next.prev = entry.prev
+	}
+	delete(m.entries, key)
+	return true
+}
+
+// Oldest returns the entry that has been in the map the longest.
+func (m *OrderedMap[K, V]) Oldest() (K, V, bool) {
+	if m.head == nil {
+		var zeroKey K
+		var zeroValue V
+		return zeroKey, zeroValue, false
+	}
+	return m.head.key, m.head.value, true
+}
+
+func (m *OrderedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for entry := m.head; entry != nil; entry = entry.next {
+			if !yield(entry.key, entry.value) {
+				return
+			}
+		}
+	}
+}
+
+func (m *OrderedMap[K, V]) Keys() []K {
+	keys := make([]K, 0, len(m.entries))
+	for key := range m.All() {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func (m *OrderedMap[K, V]) Values() []V {
+	values := make([]V, 0, len(m.entries))
+	for _, value := range m.All() {
+		values = append(values, value)
+	}
+	return values
+}
+
+func (m *OrderedMap[K, V]) Items() []Pair[K, V] {
+	items := make([]Pair[K, V], 0, len(m.entries))
+	for key, value := range m.All() {
+		items = append(items, Pair[K, V]{First: key, Second: value})
+	}
+	return items
+}
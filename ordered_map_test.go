@@ -0,0 +1,119 @@
+package godelin
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOrderedMapPreservesInsertionOrder(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	m.Set("zeta", 1)
+	m.Set("alpha", 2)
+	m.Set("mid", 3)
+	m.Set("zeta", 10) // update keeps the original position
+
+	if keys := m.Keys(); !reflect.DeepEqual(keys, []string{"zeta", "alpha", "mid"}) {
+		t.Errorf("Keys() = %v, expected [zeta alpha mid]", keys)
+	}
+	if values := m.Values(); !reflect.DeepEqual(values, []int{10, 2, 3}) {
+		t.Errorf("Values() = %v, expected [10 2 3]", values)
+	}
+	expectedItems := []Pair[string, int]{{"zeta", 10}, {"alpha", 2}, {"mid", 3}}
+	if items := m.Items(); !reflect.DeepEqual(items, expectedItems) {
+		t.Errorf("Items() = %v, expected %v", items, expectedItems)
+	}
+}
+
+func TestOrderedMapGet(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	m.Set("a", 1)
+	if value, found := m.Get("a"); value != 1 || !found {
+		t.Errorf("Get() = %v, %v, expected 1, true", value, found)
+	}
+	if value, found := m.Get("b"); value != 0 || found {
+		t.Errorf("Get() = %v, %v, expected 0, false", value, found)
+	}
+}
+
+func TestOrderedMapDelete(t *testing.T) {
+	testCases := []struct {
+		name     string
+		remove   []string
+		expected []string
+	}{
+		{name: "head", remove: []string{"a"}, expected: []string{"b", "c"}},
+		{name: "middle", remove: []string{"b"}, expected: []string{"a", "c"}},
+		{name: "tail", remove: []string{"c"}, expected: []string{"a", "b"}},
+		{name: "everything", remove: []string{"b", "a", "c"}, expected: []string{}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			m := NewOrderedMap[string, int]()
+			for i, key := range []string{"a", "b", "c"} {
+				m.Set(key, i)
+			}
+			for _, key := range testCase.remove {
+				if !m.Delete(key) {
+					t.Errorf("Delete(%q) = false, expected true", key)
+				}
+			}
+			if keys := m.Keys(); !reflect.DeepEqual(keys, testCase.expected) || m.Len() != len(testCase.expected) {
+				t.Errorf("Keys() after Delete = %v (len %d), expected %v", keys, m.Len(), testCase.expected)
+			}
+			m.Set("d", 9)
+			if keys := m.Keys(); keys[len(keys)-1] != "d" {
+				t.Errorf("Keys() after re-insert = %v, expected d to be last", keys)
+			}
+		})
+	}
+	if NewOrderedMap[string, int]().Delete("missing") {
+		t.Errorf("Delete() of a missing key = true, expected false")
+	}
+}
+
+func TestOrderedMapOldest(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	if key, value, found := m.Oldest(); key != "" || value != 0 || found {
+		t.Errorf("Oldest() on an empty map = %q, %v, %v, expected \"\", 0, false", key, value, found)
+	}
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Delete("a")
+	m.Set("a", 3)
+	if key, value, found := m.Oldest(); key != "b" || value != 2 || !found {
+		t.Errorf("Oldest() = %q, %v, %v, expected \"b\", 2, true", key, value, found)
+	}
+}
+
+func TestOrderedMapGetOrPut(t *testing.T) {
+	m := NewOrderedMap[string, []string]()
+	for _, word := range []string{"banana", "apple", "blueberry", "avocado"} {
+		group := m.GetOrPut(word[:1], func(string) []string { return nil })
+		m.Set(word[:1], append(group, word))
+	}
+	expected := []Pair[string, []string]{
+		{"b", []string{"banana", "blueberry"}},
+		{"a", []string{"apple", "avocado"}},
+	}
+	if items := m.Items(); !reflect.DeepEqual(items, expected) {
+		t.Errorf("Items() = %v, expected %v", items, expected)
+	}
+}
+
+func TestOrderedMapAllStopsEarly(t *testing.T) {
+	m := NewOrderedMap[int, int]()
+	for i := range 5 {
+		m.Set(i, i)
+	}
+	visited := 0
+	for key := range m.All() {
+		visited++
+		if key == 1 {
+			break
+		}
+	}
+	if visited != 2 {
+		t.Errorf("All() visited %d entries, expected 2", visited)
+	}
+}
@@ -0,0 +1,49 @@
+package godelin
+
+import "slices"
+
+type PageInfo struct {
+	Page       int
+	PerPage    int
+	TotalItems int
+	TotalPages int
+	HasPrev    bool
+	HasNext    bool
+}
+
+// PageCount returns how many pages of perPage items total items fill; zero items fill zero pages.
+func PageCount(total, perPage int) int {
+	if perPage <= 0 {
+		panic("PageCount: perPage must be positive")
+	}
+	pages := total / perPage
+	if total%perPage != 0 {
+		pages++
+	}
+	return pages
+}
+
+// Paginate returns a copy of the 1-based page of slice together with its PageInfo. Pages past
+// the end are empty rather than an error, so clients can detect the end from HasNext, and a
+// page below 1 is treated as the first page, since page numbers usually come from clients.
+func Paginate[T any](slice []T, page, perPage int) ([]T, PageInfo) {
+	if perPage <= 0 {
+		panic("Paginate: perPage must be positive")
+	}
+	page = max(page, 1)
+	info := PageInfo{
+		Page:       page,
+		PerPage:    perPage,
+		TotalItems: len(slice),
+		TotalPages: PageCount(len(slice), perPage),
+	}
+	info.HasPrev = page > 1
+	info.HasNext = page < info.TotalPages
+	if page > info.TotalPages {
+		return []T{}, info
+	}
+	// page-1 < TotalPages, so start stays within the slice and nothing below can overflow
+	start := (page - 1) * perPage
+	end := start + min(perPage, len(slice)-start)
+	return slices.Clone(slice[start:end]), info
+}
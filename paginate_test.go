@@ -0,0 +1,65 @@
+package godelin
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestPaginate(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5, 6, 7}
+	tests := []struct {
+		name         string
+		input        []int
+		page         int
+		perPage      int
+		expected     []int
+		expectedInfo PageInfo
+	}{
+		{"first page", items, 1, 3, []int{1, 2, 3}, PageInfo{1, 3, 7, 3, false, true}},
+		{"middle page", items, 2, 3, []int{4, 5, 6}, PageInfo{2, 3, 7, 3, true, true}},
+		{"partial last page", items, 3, 3, []int{7}, PageInfo{3, 3, 7, 3, true, false}},
+		{"past the end", items, 5, 3, []int{}, PageInfo{5, 3, 7, 3, true, false}},
+		{"exact fit", items, 1, 7, items, PageInfo{1, 7, 7, 1, false, false}},
+		{"empty", nil, 1, 10, []int{}, PageInfo{1, 10, 0, 0, false, false}},
+		{"zero page", items, 0, 3, []int{1, 2, 3}, PageInfo{1, 3, 7, 3, false, true}},
+		{"negative page", items, -4, 3, []int{1, 2, 3}, PageInfo{1, 3, 7, 3, false, true}},
+		{"huge page", items, math.MaxInt / 5, 10, []int{}, PageInfo{math.MaxInt / 5, 10, 7, 1, true, false}},
+		{"huge perPage", items, 1, math.MaxInt, items, PageInfo{1, math.MaxInt, 7, 1, false, false}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, info := Paginate(tt.input, tt.page, tt.perPage)
+			if !reflect.DeepEqual(got, tt.expected) || info != tt.expectedInfo {
+				t.Errorf("Paginate() = %v, %+v, expected %v, %+v", got, info, tt.expected, tt.expectedInfo)
+			}
+		})
+	}
+
+	page, _ := Paginate(items, 1, 2)
+	page[0] = 100
+	if items[0] != 1 {
+		t.Errorf("Paginate() page shares memory with the input")
+	}
+}
+
+func TestPageCount(t *testing.T) {
+	for _, tt := range []struct{ total, perPage, expected int }{{0, 5, 0}, {1, 5, 1}, {5, 5, 1}, {6, 5, 2}, {6, math.MaxInt, 1}} {
+		if got := PageCount(tt.total, tt.perPage); got != tt.expected {
+			t.Errorf("PageCount(%d, %d) = %d, expected %d", tt.total, tt.perPage, got, tt.expected)
+		}
+	}
+}
+
+func TestPaginatePanicsOnInvalidPerPage(t *testing.T) {
+	for _, args := range [][2]int{{1, 0}, {1, -5}} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("Paginate(%d, %d) did not panic", args[0], args[1])
+				}
+			}()
+			Paginate([]int{1}, args[0], args[1])
+		}()
+	}
+}
@@ -0,0 +1,45 @@
+package godelin
+
+func Ptr[T any](value T) *T {
+	return &value
+}
+
+// Val returns *pointer, or fallback when pointer is nil.
+func Val[T any](pointer *T, fallback T) T {
+	if pointer == nil {
+		return fallback
+	}
+	return *pointer
+}
+
+// ToPointers returns pointers to copies of the elements, so writes through them do not reach
+// slice. The copies share one allocation.
+func ToPointers[T any](slice []T) []*T {
+	copies := make([]T, len(slice))
+	copy(copies, slice)
+	pointers := make([]*T, len(copies))
+	for i := range copies {
+		pointers[i] = &copies[i]
+	}
+	return pointers
+}
+
+// Deref returns the values the non-nil pointers point to, skipping nils.
+func Deref[T any](pointers []*T) []T {
+	result := make([]T, 0, len(pointers))
+	for _, pointer := range pointers {
+		if pointer != nil {
+			result = append(result, *pointer)
+		}
+	}
+	return result
+}
+
+// DerefOr returns the values the pointers point to, with fallback in place of nils.
+func DerefOr[T any](pointers []*T, fallback T) []T {
+	result := make([]T, len(pointers))
+	for i, pointer := range pointers {
+		result[i] = Val(pointer, fallback)
+	}
+	return result
+}
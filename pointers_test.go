@@ -0,0 +1,53 @@
+package godelin
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPtrAndVal(t *testing.T) {
+	p := Ptr(5)
+	if *p != 5 {
+		t.Errorf("*Ptr(5) = %v, expected 5", *p)
+	}
+	if Ptr(5) == p {
+		t.Errorf("Ptr() returned the same pointer twice")
+	}
+	if got := Val(p, 9); got != 5 {
+		t.Errorf("Val() = %v, expected 5", got)
+	}
+	if got := Val(nil, 9); got != 9 {
+		t.Errorf("Val(nil) = %v, expected 9", got)
+	}
+}
+
+func TestToPointers(t *testing.T) {
+	input := []string{"a", "b"}
+	pointers := ToPointers(input)
+	if len(pointers) != 2 || *pointers[0] != "a" || *pointers[1] != "b" {
+		t.Fatalf("ToPointers() = %v, expected pointers to a and b", pointers)
+	}
+	*pointers[0] = "changed"
+	if input[0] != "a" {
+		t.Errorf("writing through ToPointers() changed the input")
+	}
+	if got := ToPointers([]int(nil)); !reflect.DeepEqual(got, []*int{}) {
+		t.Errorf("ToPointers(nil) = %v, expected []", got)
+	}
+}
+
+func TestDeref(t *testing.T) {
+	pointers := []*int{Ptr(1), nil, Ptr(3)}
+	if got, expected := Deref(pointers), []int{1, 3}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("Deref() = %v, expected %v", got, expected)
+	}
+	if got, expected := DerefOr(pointers, -1), []int{1, -1, 3}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("DerefOr() = %v, expected %v", got, expected)
+	}
+	if got := Deref([]*int{nil}); !reflect.DeepEqual(got, []int{}) {
+		t.Errorf("Deref([nil]) = %v, expected []", got)
+	}
+	if got, expected := Deref(ToPointers([]int{4, 5})), []int{4, 5}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("Deref(ToPointers()) = %v, expected %v", got, expected)
+	}
+}
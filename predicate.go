@@ -0,0 +1,39 @@
+package godelin
+
+func PredAnd[T any](a, b func(T) bool) func(T) bool {
+	return func(value T) bool { return a(value) && b(value) }
+}
+
+func PredOr[T any](a, b func(T) bool) func(T) bool {
+	return func(value T) bool { return a(value) || b(value) }
+}
+
+func PredNot[T any](predicate func(T) bool) func(T) bool {
+	return func(value T) bool { return !predicate(value) }
+}
+
+// AllOf returns a predicate that holds when every predicate does, evaluating them in order and
+// stopping at the first failure. With no predicates it always holds.
+func AllOf[T any](predicates ...func(T) bool) func(T) bool {
+	return func(value T) bool {
+		for _, predicate := range predicates {
+			if !predicate(value) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// AnyOf returns a predicate that holds when at least one predicate does, stopping at the first
+// success. With no predicates it never holds.
+func AnyOf[T any](predicates ...func(T) bool) func(T) bool {
+	return func(value T) bool {
+		for _, predicate := range predicates {
+			if predicate(value) {
+				return true
+			}
+		}
+		return false
+	}
+}
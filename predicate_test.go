@@ -0,0 +1,49 @@
+package godelin
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPredicateCombinators(t *testing.T) {
+	even := func(n int) bool { return n%2 == 0 }
+	positive := func(n int) bool { return n > 0 }
+	small := func(n int) bool { return n < 5 }
+	numbers := []int{-4, -3, 0, 1, 2, 6, 7}
+
+	tests := []struct {
+		name      string
+		predicate func(int) bool
+		expected  []int
+	}{
+		{"PredAnd", PredAnd(even, positive), []int{2, 6}},
+		{"PredOr", PredOr(even, positive), []int{-4, 0, 1, 2, 6, 7}},
+		{"PredNot", PredNot(even), []int{-3, 1, 7}},
+		{"AllOf", AllOf(even, positive, small), []int{2}},
+		{"AnyOf", AnyOf(PredNot(positive), PredAnd(even, small)), []int{-4, -3, 0, 2}},
+		{"AllOf empty", AllOf[int](), numbers},
+		{"AnyOf empty", AnyOf[int](), []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := Filter(numbers, tt.predicate); !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Filter(%s) = %v, expected %v", tt.name, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPredicateCombinatorsShortCircuit(t *testing.T) {
+	calls := 0
+	counted := func(int) bool { calls++; return true }
+	never := func(int) bool { return false }
+	always := func(int) bool { return true }
+
+	AllOf(never, counted)(1)
+	AnyOf(always, counted)(1)
+	PredAnd(never, counted)(1)
+	PredOr(always, counted)(1)
+	if calls != 0 {
+		t.Errorf("later predicates called %d times, expected 0", calls)
+	}
+}
@@ -0,0 +1,108 @@
+package godelin
+
+import "slices"
+
+// PriorityQueue is a binary heap that pops the least element under less first.
+type PriorityQueue[T any] struct {
+	items []T
+	less  func(T, T) bool
+}
+
+func NewPriorityQueue[T any](less func(T, T) bool, items ...T) *PriorityQueue[T] {
+	q := &PriorityQueue[T]{items: slices.Clone(items), less: less}
+	for i := len(q.items)/2 - 1; i >= 0; i-- {
+		q.down(i)
+	}
+	return q
+}
+
+func (q *PriorityQueue[T]) Len() int {
+	return len(q.items)
+}
+
+func (q *PriorityQueue[T]) Push(item T) {
+	q.items = append(q.items, item)
+	q.up(len(q.items) - 1)
+}
+
+func (q *PriorityQueue[T]) Peek() (T, bool) {
+	if len(q.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return q.items[0], true
+}
+
+func (q *PriorityQueue[T]) Pop() (T, bool) {
+	if len(q.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	top := q.items[0]
+	last := len(q.items) - 1
+	q.items[0] = q.items[last]
+	var zero T
+	q.items[last] = zero
+	q.items = q.items[:last]
+	q.down(0)
+	return top, true
+}
+
+func (q *PriorityQueue[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !q.less(q.items[i], q.items[parent]) {
+			return
+		}
+		q.items[i], q.items[parent] = q.items[parent], q.items[i]
+		i = parent
+	}
+}
+
+func (q *PriorityQueue[T]) down(i int) {
+	for {
+		smallest := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < len(q.items) && q.less(q.items[child], q.items[smallest]) {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			return
+		}
+		q.items[i], q.items[smallest] = q.items[smallest], q.items[i]
+		i = smallest
+	}
+}
+
+// TopN returns the n greatest elements under less, greatest first, in O(len(slice)·log n).
+func TopN[T any](slice []T, n int, less func(T, T) bool) []T {
+	if n < 0 {
+		panic("TopN: n must not be negative")
+	}
+	if n == 0 || len(slice) == 0 {
+		return []T{}
+	}
+	heap := NewPriorityQueue(less)
+	for _, element := range slice {
+		if heap.Len() < n {
+			heap.Push(element)
+		} else if smallest, _ := heap.Peek(); less(smallest, element) {
+			heap.Pop()
+			heap.Push(element)
+		}
+	}
+	result := make([]T, heap.Len())
+	for i := len(result) - 1; i >= 0; i-- {
+		result[i], _ = heap.Pop()
+	}
+	return result
+}
+
+// BottomN returns the n smallest elements under less, smallest first.
+func BottomN[T any](slice []T, n int, less func(T, T) bool) []T {
+	if n < 0 {
+		panic("BottomN: n must not be negative")
+	}
+	return TopN(slice, n, func(a, b T) bool { return less(b, a) })
+}
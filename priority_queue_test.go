@@ -0,0 +1,116 @@
+package godelin
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestPriorityQueuePopsInOrder(t *testing.T) {
+	q := NewPriorityQueue(func(a, b int) bool { return a < b }, 5, 3, 8)
+	for _, item := range []int{1, 9, 4, 4} {
+		q.Push(item)
+	}
+	if top, ok := q.Peek(); top != 1 || !ok || q.Len() != 7 {
+		t.Errorf("Peek() = %v, %v with Len %d, expected 1, true with Len 7", top, ok, q.Len())
+	}
+	var popped []int
+	for q.Len() > 0 {
+		item, _ := q.Pop()
+		popped = append(popped, item)
+	}
+	if expected := []int{1, 3, 4, 4, 5, 8, 9}; !reflect.DeepEqual(popped, expected) {
+		t.Errorf("Pop() order = %v, expected %v", popped, expected)
+	}
+}
+
+func TestPriorityQueueEmpty(t *testing.T) {
+	q := NewPriorityQueue(func(a, b string) bool { return a < b })
+	if item, ok := q.Peek(); item != "" || ok {
+		t.Errorf("Peek() on empty queue = %q, %v", item, ok)
+	}
+	if item, ok := q.Pop(); item != "" || ok {
+		t.Errorf("Pop() on empty queue = %q, %v", item, ok)
+	}
+}
+
+func TestPriorityQueueMatchesSort(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	input := make([]int, 500)
+	for i := range input {
+		input[i] = rng.IntN(100)
+	}
+	q := NewPriorityQueue(func(a, b int) bool { return a > b }, input...)
+	expected := slices.Clone(input)
+	slices.SortFunc(expected, func(a, b int) int { return b - a })
+	for i, want := range expected {
+		if got, _ := q.Pop(); got != want {
+			t.Fatalf("Pop() #%d = %v, expected %v", i, got, want)
+		}
+	}
+}
+
+func TestTopN(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	testCases := []struct {
+		name     string
+		input    []int
+		n        int
+		expected []int
+	}{
+		{name: "three largest", input: []int{4, 1, 9, 7, 3, 9, 2}, n: 3, expected: []int{9, 9, 7}},
+		{name: "n larger than slice", input: []int{2, 3, 1}, n: 5, expected: []int{3, 2, 1}},
+		{name: "n of zero", input: []int{1, 2}, n: 0, expected: []int{}},
+		{name: "empty slice", input: []int{}, n: 2, expected: []int{}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if actual := TopN(testCase.input, testCase.n, less); !reflect.DeepEqual(actual, testCase.expected) {
+				t.Errorf("TopN() = %v, expected %v", actual, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestTopNPanicsOnNegativeN(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected a panic but did not get one")
+		}
+	}()
+	TopN([]int{1}, -1, func(a, b int) bool { return a < b })
+}
+
+func TestBottomN(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	testCases := []struct {
+		name     string
+		input    []int
+		n        int
+		expected []int
+	}{
+		{name: "three smallest", input: []int{4, 1, 9, 7, 3, 1, 2}, n: 3, expected: []int{1, 1, 2}},
+		{name: "n larger than slice", input: []int{2, 3, 1}, n: 5, expected: []int{1, 2, 3}},
+		{name: "n of zero", input: []int{1, 2}, n: 0, expected: []int{}},
+		{name: "empty slice", input: []int{}, n: 2, expected: []int{}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if actual := BottomN(testCase.input, testCase.n, less); !reflect.DeepEqual(actual, testCase.expected) {
+				t.Errorf("BottomN() = %v, expected %v", actual, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestBottomNPanicsOnNegativeN(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected a panic but did not get one")
+		}
+	}()
+	BottomN([]int{1}, -1, func(a, b int) bool { return a < b })
+}
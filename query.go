@@ -0,0 +1,125 @@
+package godelin
+
+import "slices"
+
+type queryStageKind int
+
+const (
+	stageWhere queryStageKind = iota
+	stageOrderBy
+	stageSkip
+	stageLimit
+)
+
+type queryStage[T any] struct {
+	kind      queryStageKind
+	predicate func(T) bool
+	compare   func(T, T) int
+	n         int
+}
+
+// Query is an immutable, lazily evaluated pipeline over a slice. Every builder method returns
+// a new Query, so partially built queries can be shared and extended independently.
+type Query[T any] struct {
+	source []T
+	stages []queryStage[T]
+}
+
+func From[T any](slice []T) *Query[T] {
+	return &Query[T]{source: slice}
+}
+
+func (q *Query[T]) with(stage queryStage[T]) *Query[T] {
+	return &Query[T]{source: q.source, stages: Plus(q.stages, stage)}
+}
+
+func (q *Query[T]) Where(predicate func(T) bool) *Query[T] {
+	return q.with(queryStage[T]{kind: stageWhere, predicate: predicate})
+}
+
+// OrderBy sorts stably, so successive OrderBy calls refine earlier orderings of equal elements.
+func (q *Query[T]) OrderBy(compare func(T, T) int) *Query[T] {
+	return q.with(queryStage[T]{kind: stageOrderBy, compare: compare})
+}
+
+func (q *Query[T]) Skip(n int) *Query[T] {
+	if n < 0 {
+		panic("Query.Skip: n must not be negative")
+	}
+	return q.with(queryStage[T]{kind: stageSkip, n: n})
+}
+
+func (q *Query[T]) Limit(n int) *Query[T] {
+	if n < 0 {
+		panic("Query.Limit: n must not be negative")
+	}
+	return q.with(queryStage[T]{kind: stageLimit, n: n})
+}
+
+func (q *Query[T]) ToSlice() []T {
+	current := q.source
+	stages := q.stages
+	materialized := false
+	for len(stages) > 0 {
+		if stages[0].kind == stageOrderBy {
+			if !materialized {
+				current = Plus(current)
+				materialized = true
+			}
+			slices.SortStableFunc(current, stages[0].compare)
+			stages = stages[1:]
+			continue
+		}
+		end := slices.IndexFunc(stages, func(stage queryStage[T]) bool { return stage.kind == stageOrderBy })
+		if end < 0 {
+			end = len(stages)
+		}
+		current = runQueryStages(current, stages[:end])
+		materialized = true
+		stages = stages[end:]
+	}
+	if !materialized {
+		return Plus(current)
+	}
+	return current
+}
+
+func (q *Query[T]) Count() int {
+	return len(q.ToSlice())
+}
+
+func Select[T, R any](q *Query[T], transform func(T) R) []R {
+	return Map(q.ToSlice(), transform)
+}
+
+// runQueryStages evaluates consecutive non-sorting stages in a single pass over input and stops
+// reading as soon as a Limit stage is exhausted.
+func runQueryStages[T any](input []T, stages []queryStage[T]) []T {
+	counters := make([]int, len(stages))
+	result := make([]T, 0)
+	for _, element := range input {
+		passed, exhausted := true, false
+		for i, stage := range stages {
+			if stage.kind == stageWhere && !stage.predicate(element) ||
+				stage.kind == stageSkip && counters[i] < stage.n {
+				counters[i]++
+				passed = false
+				break
+			}
+			if stage.kind == stageLimit {
+				if counters[i] >= stage.n {
+					return result
+				}
+				counters[i]++
+				exhausted = exhausted || counters[i] == stage.n
+			}
+		}
+		if passed {
+			result = append(result, element)
+		}
+		if exhausted {
+			return result
+		}
+	}
+	return result
+}
@@ -0,0 +1,96 @@
+package godelin
+
+import (
+	"cmp"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type employee struct {
+	name   string
+	team   string
+	salary int
+}
+
+var staff = []employee{
+	{"ann", "ops", 120},
+	{"bob", "dev", 100},
+	{"cid", "dev", 150},
+	{"dee", "ops", 90},
+	{"eve", "dev", 130},
+	{"fay", "sec", 110},
+}
+
+func names(employees []employee) []string {
+	return Map(employees, func(e employee) string { return e.name })
+}
+
+func TestQuery(t *testing.T) {
+	bySalaryDesc := func(a, b employee) int { return cmp.Compare(b.salary, a.salary) }
+	byTeam := func(a, b employee) int { return strings.Compare(a.team, b.team) }
+	inDev := func(e employee) bool { return e.team == "dev" }
+
+	testCases := []struct {
+		name     string
+		query    *Query[employee]
+		expected []string
+	}{
+		{name: "no stages", query: From(staff), expected: []string{"ann", "bob", "cid", "dee", "eve", "fay"}},
+		{name: "where", query: From(staff).Where(inDev), expected: []string{"bob", "cid", "eve"}},
+		{name: "where order limit", query: From(staff).Where(inDev).OrderBy(bySalaryDesc).Limit(2), expected: []string{"cid", "eve"}},
+		{name: "skip and limit page", query: From(staff).OrderBy(bySalaryDesc).Skip(2).Limit(2), expected: []string{"ann", "fay"}},
+		{name: "limit before where", query: From(staff).Limit(3).Where(inDev), expected: []string{"bob", "cid"}},
+		{name: "skip before where", query: From(staff).Skip(2).Where(inDev), expected: []string{"cid", "eve"}},
+		{name: "stable multi-key ordering", query: From(staff).OrderBy(bySalaryDesc).OrderBy(byTeam), expected: []string{"cid", "eve", "bob", "ann", "dee", "fay"}},
+		{name: "limit zero", query: From(staff).Limit(0), expected: []string{}},
+		{name: "skip everything", query: From(staff).Skip(100), expected: []string{}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if actual := names(testCase.query.ToSlice()); !reflect.DeepEqual(actual, testCase.expected) {
+				t.Errorf("ToSlice() = %v, expected %v", actual, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestQueryLimitStopsReading(t *testing.T) {
+	visited := 0
+	input := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	actual := From(input).Where(func(n int) bool { visited++; return n%2 == 0 }).Limit(2).ToSlice()
+	if !reflect.DeepEqual(actual, []int{2, 4}) || visited != 4 {
+		t.Errorf("ToSlice() = %v after %d predicate calls, expected [2 4] after 4", actual, visited)
+	}
+}
+
+func TestQueryIsImmutable(t *testing.T) {
+	base := From(staff).Where(func(e employee) bool { return e.salary >= 110 })
+	top := base.OrderBy(func(a, b employee) int { return cmp.Compare(b.salary, a.salary) }).Limit(1)
+	if actual := names(base.ToSlice()); !reflect.DeepEqual(actual, []string{"ann", "cid", "eve", "fay"}) {
+		t.Errorf("extending a query changed the base query: %v", actual)
+	}
+	if actual := names(top.ToSlice()); !reflect.DeepEqual(actual, []string{"cid"}) {
+		t.Errorf("ToSlice() = %v, expected [cid]", actual)
+	}
+}
+
+func TestQueryDoesNotModifySource(t *testing.T) {
+	input := []int{3, 1, 2}
+	From(input).OrderBy(cmp.Compare[int]).ToSlice()
+	From(input).ToSlice()[0] = 99
+	if !reflect.DeepEqual(input, []int{3, 1, 2}) {
+		t.Errorf("query modified its source: %v", input)
+	}
+}
+
+func TestSelectAndCount(t *testing.T) {
+	query := From(staff).Where(func(e employee) bool { return e.team == "ops" })
+	if actual := Select(query, func(e employee) int { return e.salary }); !reflect.DeepEqual(actual, []int{120, 90}) {
+		t.Errorf("Select() = %v, expected [120 90]", actual)
+	}
+	if query.Count() != 2 {
+		t.Errorf("Count() = %d, expected 2", query.Count())
+	}
+}
@@ -0,0 +1,50 @@
+package godelin
+
+import (
+	"math/rand/v2"
+	"slices"
+)
+
+// The functions in this file draw from rng, or from the top-level math/rand/v2 source when rng
+// is nil; pass a seeded *rand.Rand for reproducible results.
+
+func randomIntN(rng *rand.Rand, n int) int {
+	if rng == nil {
+		return rand.IntN(n)
+	}
+	return rng.IntN(n)
+}
+
+func Shuffle[T any](slice []T, rng *rand.Rand) {
+	for i := len(slice) - 1; i > 0; i-- {
+		j := randomIntN(rng, i+1)
+		slice[i], slice[j] = slice[j], slice[i]
+	}
+}
+
+func Shuffled[T any](slice []T, rng *rand.Rand) []T {
+	result := slices.Clone(EmptyIfNil(slice))
+	Shuffle(result, rng)
+	return result
+}
+
+// Sample returns n distinct elements of slice (distinct by position), in random order.
+func Sample[T any](slice []T, n int, rng *rand.Rand) []T {
+	if n < 0 || n > len(slice) {
+		panic("Sample: n must be between 0 and the slice length")
+	}
+	pool := slices.Clone(slice)
+	for i := range n {
+		j := i + randomIntN(rng, len(pool)-i)
+		pool[i], pool[j] = pool[j], pool[i]
+	}
+	return EmptyIfNil(slices.Clip(pool[:n]))
+}
+
+func RandomElement[T any](slice []T, rng *rand.Rand) (T, bool) {
+	if len(slice) == 0 {
+		var zero T
+		return zero, false
+	}
+	return slice[randomIntN(rng, len(slice))], true
+}
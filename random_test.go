@@ -0,0 +1,85 @@
+package godelin
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func seeded() *rand.Rand {
+	return rand.New(rand.NewPCG(1, 2))
+}
+
+func TestShuffled(t *testing.T) {
+	input := countTo(20)
+	first, second := Shuffled(input, seeded()), Shuffled(input, seeded())
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("Shuffled() with equal seeds = %v and %v, expected equal results", first, second)
+	}
+	if reflect.DeepEqual(first, input) {
+		t.Errorf("Shuffled() = %v, expected a different order", first)
+	}
+	if !reflect.DeepEqual(slices.Sorted(slices.Values(first)), input) {
+		t.Errorf("Shuffled() = %v, expected a permutation of %v", first, input)
+	}
+	if !reflect.DeepEqual(input, countTo(20)) {
+		t.Errorf("Shuffled() modified its input: %v", input)
+	}
+	if got := Shuffled([]int(nil), nil); !reflect.DeepEqual(got, []int{}) {
+		t.Errorf("Shuffled(nil) = %#v, expected []int{}", got)
+	}
+}
+
+func TestShuffleIsUniform(t *testing.T) {
+	rng := seeded()
+	counts := map[[3]int]int{}
+	for range 6000 {
+		permutation := []int{0, 1, 2}
+		Shuffle(permutation, rng)
+		counts[[3]int(permutation)]++
+	}
+	for permutation, count := range counts {
+		if count < 850 || count > 1150 {
+			t.Errorf("permutation %v occurred %d times in 6000 shuffles, expected about 1000", permutation, count)
+		}
+	}
+	if len(counts) != 6 {
+		t.Errorf("Shuffle() produced %d distinct permutations of 3 elements, expected 6", len(counts))
+	}
+}
+
+func TestSample(t *testing.T) {
+	input := []string{"a", "b", "c", "d", "e"}
+	got := Sample(input, 3, seeded())
+	if len(got) != 3 || len(Distinct(got)) != 3 {
+		t.Errorf("Sample(3) = %v, expected 3 distinct elements", got)
+	}
+	for _, element := range got {
+		if !slices.Contains(input, element) {
+			t.Errorf("Sample() returned %q, which is not in the input", element)
+		}
+	}
+	if !reflect.DeepEqual(got, Sample(input, 3, seeded())) {
+		t.Errorf("Sample() is not reproducible with equal seeds")
+	}
+	if got := Sample(input, 0, nil); !reflect.DeepEqual(got, []string{}) {
+		t.Errorf("Sample(0) = %v, expected []", got)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected a panic but did not get one")
+		}
+	}()
+	Sample(input, 6, nil)
+}
+
+func TestRandomElement(t *testing.T) {
+	if got, ok := RandomElement([]int{7}, nil); got != 7 || !ok {
+		t.Errorf("RandomElement([7]) = %v, %v, expected 7, true", got, ok)
+	}
+	if got, ok := RandomElement([]int{}, seeded()); got != 0 || ok {
+		t.Errorf("RandomElement([]) = %v, %v, expected 0, false", got, ok)
+	}
+}
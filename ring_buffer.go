@@ -0,0 +1,67 @@
+package godelin
+
+// RingBuffer holds at most a fixed number of elements. When full, Push either overwrites the
+// oldest element or rejects the new one, depending on the overwrite flag.
+type RingBuffer[T any] struct {
+	elements  []T
+	start     int
+	length    int
+	overwrite bool
+}
+
+func NewRingBuffer[T any](capacity int, overwrite bool) *RingBuffer[T] {
+	if capacity <= 0 {
+		panic("NewRingBuffer: capacity must be positive")
+	}
+	return &RingBuffer[T]{elements: make([]T, capacity), overwrite: overwrite}
+}
+
+func (r *RingBuffer[T]) Len() int {
+	return r.length
+}
+
+func (r *RingBuffer[T]) Cap() int {
+	return len(r.elements)
+}
+
+// Push reports whether value was stored; it is false only when the buffer is full and not overwriting.
+func (r *RingBuffer[T]) Push(value T) bool {
+	if r.length == len(r.elements) {
+		if !r.overwrite {
+			return false
+		}
+		r.elements[r.start] = value
+		r.start = (r.start + 1) % len(r.elements)
+		return true
+	}
+	r.elements[(r.start+r.length)%len(r.elements)] = value
+	r.length++
+	return true
+}
+
+// Pop removes and returns the oldest element.
+func (r *RingBuffer[T]) Pop() (T, bool) {
+	var zero T
+	if r.length == 0 {
+		return zero, false
+	}
+	value := r.elements[r.start]
+	r.elements[r.start] = zero
+	r.start = (r.start + 1) % len(r.elements)
+	r.length--
+	return value, true
+}
+
+// Snapshot returns the elements from oldest to newest.
+func (r *RingBuffer[T]) Snapshot() []T {
+	result := make([]T, 0, r.length)
+	for i := range r.length {
+		result = append(result, r.elements[(r.start+i)%len(r.elements)])
+	}
+	return result
+}
+
+// Windowed is equivalent to Windowed(r.Snapshot(), size, step).
+func (r *RingBuffer[T]) Windowed(size, step int) [][]T {
+	return Windowed(r.Snapshot(), size, step)
+}
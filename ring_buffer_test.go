@@ -0,0 +1,64 @@
+package godelin
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRingBufferPush(t *testing.T) {
+	tests := []struct {
+		name      string
+		overwrite bool
+		pushes    []int
+		expected  []int
+		accepted  []bool
+	}{
+		{"not full", false, []int{1, 2}, []int{1, 2}, []bool{true, true}},
+		{"overwrite oldest", true, []int{1, 2, 3, 4, 5}, []int{3, 4, 5}, []bool{true, true, true, true, true}},
+		{"reject when full", false, []int{1, 2, 3, 4}, []int{1, 2, 3}, []bool{true, true, true, false}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buffer := NewRingBuffer[int](3, tt.overwrite)
+			accepted := Map(tt.pushes, buffer.Push)
+			if !reflect.DeepEqual(accepted, tt.accepted) {
+				t.Errorf("Push() = %v, expected %v", accepted, tt.accepted)
+			}
+			if result := buffer.Snapshot(); !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Snapshot() = %v, expected %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRingBufferPop(t *testing.T) {
+	buffer := NewRingBuffer[string](2, true)
+	buffer.Push("a")
+	buffer.Push("b")
+	buffer.Push("c")
+	popped := []string{}
+	for value, ok := buffer.Pop(); ok; value, ok = buffer.Pop() {
+		popped = append(popped, value)
+	}
+	if expected := []string{"b", "c"}; !reflect.DeepEqual(popped, expected) {
+		t.Errorf("Pop() sequence = %v, expected %v", popped, expected)
+	}
+	buffer.Push("d")
+	if result := buffer.Snapshot(); !reflect.DeepEqual(result, []string{"d"}) || buffer.Len() != 1 {
+		t.Errorf("Snapshot() after draining = %v, expected [d]", result)
+	}
+}
+
+func TestRingBufferWindowed(t *testing.T) {
+	buffer := NewRingBuffer[int](4, true)
+	for i := 1; i <= 6; i++ {
+		buffer.Push(i)
+	}
+	expected := [][]int{{3, 4}, {5, 6}}
+	if result := buffer.Windowed(2, 2); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Windowed(2, 2) = %v, expected %v", result, expected)
+	}
+	if result := NewRingBuffer[int](1, false).Snapshot(); !reflect.DeepEqual(result, []int{}) {
+		t.Errorf("Snapshot() of empty buffer = %v, expected []", result)
+	}
+}
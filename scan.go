@@ -0,0 +1,59 @@
+package godelin
+
+import (
+	"bufio"
+	"io"
+	"iter"
+)
+
+// ScanSeq yields the tokens produced by split. A read or split error is yielded once, after
+// the tokens read before it, and ends the sequence. Tokens longer than bufio.MaxScanTokenSize
+// fail with bufio.ErrTooLong.
+func ScanSeq(r io.Reader, split bufio.SplitFunc) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		scanner := bufio.NewScanner(r)
+		scanner.Split(split)
+		for scanner.Scan() {
+			if !yield(scanner.Text(), nil) {
+				return
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			yield("", err)
+		}
+	}
+}
+
+func WordsSeq(r io.Reader) iter.Seq2[string, error] {
+	return ScanSeq(r, bufio.ScanWords)
+}
+
+// LinesChunkedSeq yields lines in freshly allocated batches of n; the last batch may be
+// shorter. On error the lines read so far are yielded before the error.
+func LinesChunkedSeq(r io.Reader, n int) iter.Seq2[[]string, error] {
+	if n <= 0 {
+		panic("LinesChunkedSeq: n must be positive")
+	}
+	return func(yield func([]string, error) bool) {
+		chunk := make([]string, 0, n)
+		for line, err := range ScanSeq(r, bufio.ScanLines) {
+			if err != nil {
+				if len(chunk) > 0 && !yield(chunk, nil) {
+					return
+				}
+				yield(nil, err)
+				return
+			}
+			chunk = append(chunk, line)
+			if len(chunk) == n {
+				if !yield(chunk, nil) {
+					return
+				}
+				chunk = make([]string, 0, n)
+			}
+		}
+		if len(chunk) > 0 {
+			yield(chunk, nil)
+		}
+	}
+}
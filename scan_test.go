@@ -0,0 +1,79 @@
+package godelin
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"iter"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func collectSeq2[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	result := make([]T, 0)
+	for value, err := range seq {
+		if err != nil {
+			return result, err
+		}
+		result = append(result, value)
+	}
+	return result, nil
+}
+
+func TestScanSeq(t *testing.T) {
+	lines, err := collectSeq2(ScanSeq(strings.NewReader("a\nb\r\n\nc"), bufio.ScanLines))
+	if expected := []string{"a", "b", "", "c"}; err != nil || !reflect.DeepEqual(lines, expected) {
+		t.Errorf("ScanSeq() = %q, %v, expected %q, nil", lines, err, expected)
+	}
+
+	runes, _ := collectSeq2(ScanSeq(strings.NewReader("hé"), bufio.ScanRunes))
+	if expected := []string{"h", "é"}; !reflect.DeepEqual(runes, expected) {
+		t.Errorf("ScanSeq(ScanRunes) = %q, expected %q", runes, expected)
+	}
+
+	failure := errors.New("disk gone")
+	reader := io.MultiReader(strings.NewReader("x\ny\n"), iotest.ErrReader(failure))
+	lines, err = collectSeq2(ScanSeq(reader, bufio.ScanLines))
+	if expected := []string{"x", "y"}; !errors.Is(err, failure) || !reflect.DeepEqual(lines, expected) {
+		t.Errorf("ScanSeq() = %q, %v, expected %q, %v", lines, err, expected, failure)
+	}
+}
+
+func TestWordsSeq(t *testing.T) {
+	words, err := collectSeq2(WordsSeq(strings.NewReader("  the quick\n\tbrown  fox ")))
+	if expected := []string{"the", "quick", "brown", "fox"}; err != nil || !reflect.DeepEqual(words, expected) {
+		t.Errorf("WordsSeq() = %q, %v, expected %q, nil", words, err, expected)
+	}
+
+	pulled := 0
+	for range WordsSeq(strings.NewReader("one two three")) {
+		pulled++
+		break
+	}
+	if pulled != 1 {
+		t.Errorf("WordsSeq() yielded %d words after break, expected 1", pulled)
+	}
+}
+
+func TestLinesChunkedSeq(t *testing.T) {
+	chunks, err := collectSeq2(LinesChunkedSeq(strings.NewReader("1\n2\n3\n4\n5\n"), 2))
+	if expected := [][]string{{"1", "2"}, {"3", "4"}, {"5"}}; err != nil || !reflect.DeepEqual(chunks, expected) {
+		t.Errorf("LinesChunkedSeq() = %q, %v, expected %q, nil", chunks, err, expected)
+	}
+
+	failure := errors.New("connection reset")
+	reader := io.MultiReader(strings.NewReader("1\n2\n3\n"), iotest.ErrReader(failure))
+	chunks, err = collectSeq2(LinesChunkedSeq(reader, 2))
+	if expected := [][]string{{"1", "2"}, {"3"}}; !errors.Is(err, failure) || !reflect.DeepEqual(chunks, expected) {
+		t.Errorf("LinesChunkedSeq() = %q, %v, expected %q, %v", chunks, err, expected, failure)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected a panic but did not get one")
+		}
+	}()
+	LinesChunkedSeq(strings.NewReader(""), 0)
+}
@@ -0,0 +1,17 @@
+package godelin
+
+func Let[T, R any](value T, transform func(T) R) R {
+	return transform(value)
+}
+
+func TakeIf[T any](value T, predicate func(T) bool) (T, bool) {
+	if predicate(value) {
+		return value, true
+	}
+	var zero T
+	return zero, false
+}
+
+func TakeUnless[T any](value T, predicate func(T) bool) (T, bool) {
+	return TakeIf(value, func(v T) bool { return !predicate(v) })
+}
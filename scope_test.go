@@ -0,0 +1,32 @@
+package godelin
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLet(t *testing.T) {
+	if actual := Let("  padded ", strings.TrimSpace); actual != "padded" {
+		t.Errorf("Let() = %q, expected %q", actual, "padded")
+	}
+}
+
+func TestTakeIf(t *testing.T) {
+	isPositive := func(x int) bool { return x > 0 }
+	if actual, ok := TakeIf(5, isPositive); actual != 5 || !ok {
+		t.Errorf("TakeIf() = %v, %v, expected 5, true", actual, ok)
+	}
+	if actual, ok := TakeIf(-5, isPositive); actual != 0 || ok {
+		t.Errorf("TakeIf() = %v, %v, expected 0, false", actual, ok)
+	}
+}
+
+func TestTakeUnless(t *testing.T) {
+	isBlank := func(s string) bool { return strings.TrimSpace(s) == "" }
+	if actual, ok := TakeUnless("go", isBlank); actual != "go" || !ok {
+		t.Errorf("TakeUnless() = %q, %v, expected %q, true", actual, ok, "go")
+	}
+	if actual, ok := TakeUnless("  ", isBlank); actual != "" || ok {
+		t.Errorf("TakeUnless() = %q, %v, expected %q, false", actual, ok, "")
+	}
+}
@@ -0,0 +1,64 @@
+package godelin
+
+import "iter"
+
+func MapSeq[T, R any](seq iter.Seq[T], fn func(T) R) iter.Seq[R] {
+	return func(yield func(R) bool) {
+		for element := range seq {
+			if !yield(fn(element)) {
+				return
+			}
+		}
+	}
+}
+
+func FilterSeq[T any](seq iter.Seq[T], predicate func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for element := range seq {
+			if predicate(element) && !yield(element) {
+				return
+			}
+		}
+	}
+}
+
+// TakeSeq stops pulling from seq as soon as n elements have been yielded.
+func TakeSeq[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	if n < 0 {
+		panic("TakeSeq: n must not be negative")
+	}
+	return func(yield func(T) bool) {
+		if n == 0 {
+			return
+		}
+		taken := 0
+		for element := range seq {
+			taken++
+			if !yield(element) || taken == n {
+				return
+			}
+		}
+	}
+}
+
+// ChunkedSeq yields freshly allocated chunks of size elements; the last one may be shorter.
+func ChunkedSeq[T any](seq iter.Seq[T], size int) iter.Seq[[]T] {
+	if size <= 0 {
+		panic("ChunkedSeq: size must be positive")
+	}
+	return func(yield func([]T) bool) {
+		chunk := make([]T, 0, size)
+		for element := range seq {
+			chunk = append(chunk, element)
+			if len(chunk) == size {
+				if !yield(chunk) {
+					return
+				}
+				chunk = make([]T, 0, size)
+			}
+		}
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package godelin
+
+import (
+	"iter"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// countingSeq yields 1..n and records how many elements were pulled.
+func countingSeq(n int, pulled *int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := 1; i <= n; i++ {
+			*pulled++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func TestMapSeq(t *testing.T) {
+	result := slices.Collect(MapSeq(slices.Values([]int{1, 2, 3}), func(n int) string { return string(rune('a' + n - 1)) }))
+	if expected := []string{"a", "b", "c"}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("MapSeq() = %v, expected %v", result, expected)
+	}
+}
+
+func TestFilterSeq(t *testing.T) {
+	result := slices.Collect(FilterSeq(slices.Values([]int{1, 2, 3, 4, 5}), func(n int) bool { return n%2 == 0 }))
+	if expected := []int{2, 4}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("FilterSeq() = %v, expected %v", result, expected)
+	}
+}
+
+func TestTakeSeq(t *testing.T) {
+	tests := []struct {
+		name           string
+		n              int
+		expected       []int
+		expectedPulled int
+	}{
+		{"fewer than available", 3, []int{1, 2, 3}, 3},
+		{"more than available", 10, []int{1, 2, 3, 4, 5}, 5},
+		{"zero", 0, nil, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pulled := 0
+			result := slices.Collect(TakeSeq(countingSeq(5, &pulled), tt.n))
+			if !reflect.DeepEqual(result, tt.expected) || pulled != tt.expectedPulled {
+				t.Errorf("TakeSeq(%d) = %v after pulling %d, expected %v after %d", tt.n, result, pulled, tt.expected, tt.expectedPulled)
+			}
+		})
+	}
+}
+
+func TestChunkedSeq(t *testing.T) {
+	pulled := 0
+	result := slices.Collect(ChunkedSeq(countingSeq(5, &pulled), 2))
+	if expected := [][]int{{1, 2}, {3, 4}, {5}}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("ChunkedSeq() = %v, expected %v", result, expected)
+	}
+	pulled = 0
+	for range ChunkedSeq(countingSeq(100, &pulled), 3) {
+		break
+	}
+	if pulled != 3 {
+		t.Errorf("ChunkedSeq() pulled %d elements for one chunk, expected 3", pulled)
+	}
+}
+
+func TestSeqFunctionsCompose(t *testing.T) {
+	pulled := 0
+	squares := MapSeq(FilterSeq(countingSeq(1000, &pulled), func(n int) bool { return n%2 == 0 }), func(n int) int { return n * n })
+	result := slices.Collect(TakeSeq(squares, 3))
+	if expected := []int{4, 16, 36}; !reflect.DeepEqual(result, expected) || pulled != 6 {
+		t.Errorf("pipeline = %v after pulling %d, expected %v after 6", result, pulled, expected)
+	}
+}
@@ -0,0 +1,179 @@
+package godelin
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+)
+
+// Series is a named, immutable sequence of values with optional unique labels. Operations
+// between two labeled series align values by label; otherwise they align by position.
+type Series[T Number] struct {
+	name   string
+	labels []string
+	index  map[string]int
+	values []T
+}
+
+func NewSeries[T Number](name string, values []T) *Series[T] {
+	return &Series[T]{name: name, values: slices.Clone(EmptyIfNil(values))}
+}
+
+func NewLabeledSeries[T Number](name string, labels []string, values []T) *Series[T] {
+	if len(labels) != len(values) {
+		panic("NewLabeledSeries: labels and values must have equal length")
+	}
+	series := labeledSeries(name, slices.Clone(labels), slices.Clone(values))
+	if len(series.index) != len(labels) {
+		panic("NewLabeledSeries: labels must be unique")
+	}
+	return series
+}
+
+func labeledSeries[T Number](name string, labels []string, values []T) *Series[T] {
+	index := make(map[string]int, len(labels))
+	for i, label := range labels {
+		index[label] = i
+	}
+	return &Series[T]{name: name, labels: labels, index: index, values: values}
+}
+
+func (s *Series[T]) Name() string {
+	return s.name
+}
+
+func (s *Series[T]) Len() int {
+	return len(s.values)
+}
+
+func (s *Series[T]) Values() []T {
+	return slices.Clone(s.values)
+}
+
+// Labels returns nil for an unlabeled series.
+func (s *Series[T]) Labels() []string {
+	return slices.Clone(s.labels)
+}
+
+func (s *Series[T]) Get(label string) (T, bool) {
+	i, ok := s.index[label]
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	return s.values[i], true
+}
+
+func (s *Series[T]) Sum() T {
+	return Fold(s.values, 0, func(acc, value T) T { return acc + value })
+}
+
+// Mean returns NaN for an empty series.
+func (s *Series[T]) Mean() float64 {
+	if len(s.values) == 0 {
+		return math.NaN()
+	}
+	return float64(s.Sum()) / float64(len(s.values))
+}
+
+func (s *Series[T]) Rename(name string) *Series[T] {
+	return &Series[T]{name: name, labels: s.labels, index: s.index, values: s.values}
+}
+
+// Map keeps the name and labels; use MapSeries to change the value type.
+func (s *Series[T]) Map(fn func(T) T) *Series[T] {
+	return MapSeries(s, fn)
+}
+
+func MapSeries[T, R Number](s *Series[T], fn func(T) R) *Series[R] {
+	return &Series[R]{name: s.name, labels: s.labels, index: s.index, values: Map(s.values, fn)}
+}
+
+// Align returns both series restricted to their common labels, in s's order. Unlabeled series
+// are aligned by position and truncated to the shorter one.
+func (s *Series[T]) Align(other *Series[T]) (*Series[T], *Series[T]) {
+	if s.labels == nil || other.labels == nil {
+		n := min(len(s.values), len(other.values))
+		return &Series[T]{name: s.name, values: s.values[:n:n]}, &Series[T]{name: other.name, values: other.values[:n:n]}
+	}
+	labels := make([]string, 0)
+	left := make([]T, 0)
+	right := make([]T, 0)
+	for i, label := range s.labels {
+		if value, ok := other.Get(label); ok {
+			labels = append(labels, label)
+			left = append(left, s.values[i])
+			right = append(right, value)
+		}
+	}
+	return labeledSeries(s.name, labels, left), labeledSeries(other.name, labels, right)
+}
+
+// ZipWith aligns s with other as Align does and combines the paired values with fn. The result
+// keeps s's name.
+func (s *Series[T]) ZipWith(other *Series[T], fn func(T, T) T) *Series[T] {
+	left, right := s.Align(other)
+	values := make([]T, len(left.values))
+	for i := range values {
+		values[i] = fn(left.values[i], right.values[i])
+	}
+	return &Series[T]{name: s.name, labels: left.labels, index: left.index, values: values}
+}
+
+func (s *Series[T]) String() string {
+	if s.labels == nil {
+		return fmt.Sprintf("%s%v", s.name, s.values)
+	}
+	return fmt.Sprintf("%s%v", s.name, Zip(s.labels, s.values))
+}
+
+// MissingPolicy decides what AlignByKey does with keys present in only one of the maps.
+type MissingPolicy int
+
+const (
+	MissingDrop MissingPolicy = iota // keep only keys present in both maps
+	MissingNaN                       // keep every key, filling the absent side with NaN
+	MissingZero                      // keep every key, filling the absent side with 0
+)
+
+// AlignByKey returns the keys in ascending order together with the matching values from a and
+// b, so the two vectors can be passed to PearsonCorrelation and similar paired functions.
+func AlignByKey[K cmp.Ordered](a, b map[K]float64, missing MissingPolicy) ([]K, []float64, []float64) {
+	keys := slices.Collect(maps.Keys(a))
+	if missing != MissingDrop {
+		for key := range b {
+			if _, inA := a[key]; !inA {
+				keys = append(keys, key)
+			}
+		}
+	}
+	slices.Sort(keys)
+	fill := 0.0
+	if missing == MissingNaN {
+		fill = math.NaN()
+	}
+	alignedKeys := make([]K, 0, len(keys))
+	left := make([]float64, 0, len(keys))
+	right := make([]float64, 0, len(keys))
+	for _, key := range keys {
+		valueA, inA := a[key]
+		valueB, inB := b[key]
+		if !inA || !inB {
+			if missing == MissingDrop {
+				continue
+			}
+			if !inA {
+				valueA = fill
+			}
+			if !inB {
+				valueB = fill
+			}
+		}
+		alignedKeys = append(alignedKeys, key)
+		left = append(left, valueA)
+		right = append(right, valueB)
+	}
+	return alignedKeys, left, right
+}
@@ -0,0 +1,100 @@
+package godelin
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestSeriesAggregates(t *testing.T) {
+	series := NewSeries("latency", []int{10, 20, 30, 40})
+	if series.Sum() != 100 || series.Mean() != 25 || series.Len() != 4 {
+		t.Errorf("Sum(), Mean(), Len() = %v, %v, %v, expected 100, 25, 4", series.Sum(), series.Mean(), series.Len())
+	}
+	if mean := NewSeries[float64]("empty", nil).Mean(); !math.IsNaN(mean) {
+		t.Errorf("Mean() of empty series = %v, expected NaN", mean)
+	}
+}
+
+func TestSeriesMap(t *testing.T) {
+	series := NewLabeledSeries("bytes", []string{"a", "b"}, []int{1024, 2048})
+	doubled := series.Map(func(v int) int { return v * 2 })
+	if doubled.Name() != "bytes" || !reflect.DeepEqual(doubled.Values(), []int{2048, 4096}) || !reflect.DeepEqual(doubled.Labels(), []string{"a", "b"}) {
+		t.Errorf("Map() = %v, expected bytes[{a 2048} {b 4096}]", doubled)
+	}
+	kib := MapSeries(series, func(v int) float64 { return float64(v) / 1024 })
+	if value, ok := kib.Get("b"); !ok || value != 2 {
+		t.Errorf("MapSeries().Get(b) = %v, %v, expected 2, true", value, ok)
+	}
+	if !reflect.DeepEqual(series.Values(), []int{1024, 2048}) {
+		t.Errorf("Map() modified the original series: %v", series)
+	}
+}
+
+func TestSeriesZipWithByLabel(t *testing.T) {
+	requests := NewLabeledSeries("requests", []string{"mon", "tue", "wed"}, []float64{100, 200, 300})
+	failures := NewLabeledSeries("errors", []string{"wed", "mon", "thu"}, []float64{30, 5, 1})
+	rate := requests.ZipWith(failures, func(r, e float64) float64 { return e / r })
+	if !reflect.DeepEqual(rate.Labels(), []string{"mon", "wed"}) || !reflect.DeepEqual(rate.Values(), []float64{0.05, 0.1}) {
+		t.Errorf("ZipWith() = %v, expected requests[{mon 0.05} {wed 0.1}]", rate)
+	}
+	left, right := requests.Align(failures)
+	if !reflect.DeepEqual(left.Values(), []float64{100, 300}) || !reflect.DeepEqual(right.Values(), []float64{5, 30}) || right.Name() != "errors" {
+		t.Errorf("Align() = %v, %v, expected requests[100 300], errors[5 30]", left, right)
+	}
+}
+
+func TestSeriesZipWithByPosition(t *testing.T) {
+	a := NewSeries("a", []int{1, 2, 3})
+	b := NewSeries("b", []int{10, 20})
+	sum := a.ZipWith(b, func(x, y int) int { return x + y })
+	if !reflect.DeepEqual(sum.Values(), []int{11, 22}) || sum.Labels() != nil {
+		t.Errorf("ZipWith() = %v, expected a[11 22]", sum)
+	}
+}
+
+func TestNewLabeledSeriesPanics(t *testing.T) {
+	for name, fn := range map[string]func(){
+		"length mismatch":  func() { NewLabeledSeries("s", []string{"a"}, []int{1, 2}) },
+		"duplicate labels": func() { NewLabeledSeries("s", []string{"a", "a"}, []int{1, 2}) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("expected a panic but did not get one")
+				}
+			}()
+			fn()
+		})
+	}
+}
+
+func TestAlignByKey(t *testing.T) {
+	a := map[string]float64{"a": 1, "b": 2, "c": 3}
+	b := map[string]float64{"b": 20, "c": 30, "d": 40}
+	nan := math.NaN()
+	testCases := []struct {
+		name          string
+		missing       MissingPolicy
+		expectedKeys  []string
+		expectedLeft  []float64
+		expectedRight []float64
+	}{
+		{"drop", MissingDrop, []string{"b", "c"}, []float64{2, 3}, []float64{20, 30}},
+		{"zero", MissingZero, []string{"a", "b", "c", "d"}, []float64{1, 2, 3, 0}, []float64{0, 20, 30, 40}},
+		{"nan", MissingNaN, []string{"a", "b", "c", "d"}, []float64{1, 2, 3, nan}, []float64{nan, 20, 30, 40}},
+	}
+	sameFloats := func(x, y []float64) bool {
+		return len(x) == len(y) && All(Zip(x, y), func(p Pair[float64, float64]) bool {
+			return p.First == p.Second || math.IsNaN(p.First) && math.IsNaN(p.Second)
+		})
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			keys, left, right := AlignByKey(a, b, tc.missing)
+			if !reflect.DeepEqual(keys, tc.expectedKeys) || !sameFloats(left, tc.expectedLeft) || !sameFloats(right, tc.expectedRight) {
+				t.Errorf("AlignByKey() = %v, %v, %v, expected %v, %v, %v", keys, left, right, tc.expectedKeys, tc.expectedLeft, tc.expectedRight)
+			}
+		})
+	}
+}
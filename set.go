@@ -0,0 +1,97 @@
+package godelin
+
+// Hasher and Equaler define a custom notion of equality: elements that are Equal must have
+// the same Hash.
+type Hasher[T any] interface {
+	Hash(T) uint64
+}
+
+type Equaler[T any] interface {
+	Equal(a, b T) bool
+}
+
+type HasherFunc[T any] func(T) uint64
+
+func (f HasherFunc[T]) Hash(value T) uint64 {
+	return f(value)
+}
+
+type EqualerFunc[T any] func(a, b T) bool
+
+func (f EqualerFunc[T]) Equal(a, b T) bool {
+	return f(a, b)
+}
+
+// Set holds elements that are distinct under its Hasher and Equaler. The first element added
+// from each equivalence class is the one kept.
+type Set[T any] struct {
+	hasher  Hasher[T]
+	equaler Equaler[T]
+	buckets map[uint64][]T
+	size    int
+}
+
+func NewSetWith[T any](hasher Hasher[T], equaler Equaler[T]) *Set[T] {
+	return &Set[T]{hasher: hasher, equaler: equaler, buckets: make(map[uint64][]T)}
+}
+
+func (s *Set[T]) find(value T) (uint64, int) {
+	hash := s.hasher.Hash(value)
+	for i, existing := range s.buckets[hash] {
+		if s.equaler.Equal(existing, value) {
+			return hash, i
+		}
+	}
+	return hash, -1
+}
+
+// Add reports whether value was added, which is false when an equal element is already present.
+func (s *Set[T]) Add(value T) bool {
+	hash, index := s.find(value)
+	if index >= 0 {
+		return false
+	}
+	s.buckets[hash] = append(s.buckets[hash], value)
+	s.size++
+	return true
+}
+
+func (s *Set[T]) Contains(value T) bool {
+	_, index := s.find(value)
+	return index >= 0
+}
+
+func (s *Set[T]) Remove(value T) bool {
+	hash, index := s.find(value)
+	if index < 0 {
+		return false
+	}
+	bucket := s.buckets[hash]
+	if len(bucket) == 1 {
+		delete(s.buckets, hash)
+	} else {
+		s.buckets[hash] = append(bucket[:index:index], bucket[index+1:]...)
+	}
+	s.size--
+	return true
+}
+
+func (s *Set[T]) Len() int {
+	return s.size
+}
+
+// Values returns the elements in an unspecified order.
+func (s *Set[T]) Values() []T {
+	result := make([]T, 0, s.size)
+	for _, bucket := range s.buckets {
+		result = append(result, bucket...)
+	}
+	return result
+}
+
+// DistinctWith keeps the first element of each equivalence class defined by hasher and
+// equaler, preserving order.
+func DistinctWith[T any](slice []T, hasher Hasher[T], equaler Equaler[T]) []T {
+	seen := NewSetWith(hasher, equaler)
+	return Filter(slice, seen.Add)
+}
@@ -0,0 +1,50 @@
+package godelin
+
+import (
+	"hash/maphash"
+	"slices"
+	"strings"
+	"testing"
+)
+
+var caseInsensitiveSeed = maphash.MakeSeed()
+
+var (
+	caseInsensitiveHasher  = HasherFunc[string](func(s string) uint64 { return maphash.String(caseInsensitiveSeed, strings.ToLower(s)) })
+	caseInsensitiveEqualer = EqualerFunc[string](strings.EqualFold)
+)
+
+func TestSetWith(t *testing.T) {
+	set := NewSetWith[string](caseInsensitiveHasher, caseInsensitiveEqualer)
+	if !set.Add("Go") || set.Add("GO") || !set.Add("Rust") {
+		t.Errorf("Add() did not treat case variants as equal")
+	}
+	if set.Len() != 2 || !set.Contains("go") || set.Contains("zig") {
+		t.Errorf("Len() = %d, Contains(go) = %v, Contains(zig) = %v, expected 2, true, false", set.Len(), set.Contains("go"), set.Contains("zig"))
+	}
+	if values := slices.Sorted(slices.Values(set.Values())); !slices.Equal(values, []string{"Go", "Rust"}) {
+		t.Errorf("Values() = %v, expected the first spelling of each element", values)
+	}
+	if !set.Remove("RUST") || set.Remove("rust") || set.Len() != 1 {
+		t.Errorf("Remove() did not remove exactly one element")
+	}
+}
+
+func TestSetWithHashCollisions(t *testing.T) {
+	constant := HasherFunc[int](func(int) uint64 { return 0 })
+	set := NewSetWith[int](constant, EqualerFunc[int](func(a, b int) bool { return a == b }))
+	for _, value := range []int{1, 2, 3, 2} {
+		set.Add(value)
+	}
+	set.Remove(2)
+	if set.Len() != 2 || !set.Contains(1) || !set.Contains(3) || set.Contains(2) {
+		t.Errorf("set with colliding hashes holds %v, expected [1 3]", set.Values())
+	}
+}
+
+func TestDistinctWith(t *testing.T) {
+	got := DistinctWith([]string{"a", "B", "A", "b", "c"}, caseInsensitiveHasher, caseInsensitiveEqualer)
+	if expected := []string{"a", "B", "c"}; !slices.Equal(got, expected) {
+		t.Errorf("DistinctWith() = %v, expected %v", got, expected)
+	}
+}
@@ -0,0 +1,25 @@
+package godelin
+
+// AssignStickyShards distributes keys over workers with rendezvous hashing: every key goes to
+// the worker with the highest hash score for it, so changing the worker count only moves the
+// keys whose best-scoring worker was added or removed. Every worker gets an entry, possibly empty.
+func AssignStickyShards[K comparable](keys []K, workers int) map[int][]K {
+	if workers <= 0 {
+		panic("AssignStickyShards: workers must be positive")
+	}
+	result := make(map[int][]K, workers)
+	for worker := 0; worker < workers; worker++ {
+		result[worker] = []K{}
+	}
+	for _, key := range keys {
+		keyHash := stableHash(key)
+		best, bestScore := 0, uint64(0)
+		for worker := 0; worker < workers; worker++ {
+			if score := mix64(keyHash ^ mix64(uint64(worker)+1)); score > bestScore || worker == 0 {
+				best, bestScore = worker, score
+			}
+		}
+		result[best] = append(result[best], key)
+	}
+	return result
+}
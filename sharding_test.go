@@ -0,0 +1,81 @@
+package godelin
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func shardKeys(n int) []string {
+	keys := make([]string, n)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("tenant-%d", i)
+	}
+	return keys
+}
+
+func ownerOf[K comparable](assignment map[int][]K) map[K]int {
+	owners := make(map[K]int)
+	for worker, keys := range assignment {
+		for _, key := range keys {
+			owners[key] = worker
+		}
+	}
+	return owners
+}
+
+func TestAssignStickyShardsIsDeterministic(t *testing.T) {
+	keys := shardKeys(200)
+	first := AssignStickyShards(keys, 4)
+	second := AssignStickyShards(keys, 4)
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("AssignStickyShards() is not deterministic")
+	}
+	if len(ownerOf(first)) != len(keys) {
+		t.Errorf("AssignStickyShards() assigned %d keys, expected %d", len(ownerOf(first)), len(keys))
+	}
+}
+
+func TestAssignStickyShardsIsBalanced(t *testing.T) {
+	keys := shardKeys(4000)
+	for worker, assigned := range AssignStickyShards(keys, 4) {
+		if len(assigned) < 800 || len(assigned) > 1200 {
+			t.Errorf("worker %d got %d keys, expected roughly 1000", worker, len(assigned))
+		}
+	}
+}
+
+func TestAssignStickyShardsMovesMinimally(t *testing.T) {
+	keys := shardKeys(1000)
+	before := ownerOf(AssignStickyShards(keys, 5))
+	after := ownerOf(AssignStickyShards(keys, 6))
+	moved := 0
+	for _, key := range keys {
+		if before[key] != after[key] {
+			moved++
+			if after[key] != 5 {
+				t.Errorf("key %s moved from worker %d to existing worker %d", key, before[key], after[key])
+			}
+		}
+	}
+	if moved == 0 || moved > 300 {
+		t.Errorf("%d of %d keys moved when adding a worker, expected about 1/6", moved, len(keys))
+	}
+}
+
+func TestAssignStickyShardsEmptyWorkers(t *testing.T) {
+	actual := AssignStickyShards([]int{}, 3)
+	expected := map[int][]int{0: {}, 1: {}, 2: {}}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("AssignStickyShards() = %v, expected %v", actual, expected)
+	}
+}
+
+func TestAssignStickyShardsPanicsWithoutWorkers(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected a panic but did not get one")
+		}
+	}()
+	AssignStickyShards([]int{1}, 0)
+}
@@ -0,0 +1,283 @@
+package godelin
+
+import (
+	"hash/maphash"
+	"iter"
+	"math/bits"
+	"sync"
+	"sync/atomic"
+)
+
+// SnapshotMap is a concurrent map whose Snapshot is O(1): it is backed by a persistent
+// hash array mapped trie, so every write produces a new root that shares all untouched
+// nodes with the previous one. Reads never block; writes are serialized.
+type SnapshotMap[K comparable, V any] struct {
+	mu      sync.Mutex
+	current atomic.Pointer[MapSnapshot[K, V]]
+}
+
+// MapSnapshot is an immutable view of a SnapshotMap at one point in time.
+type MapSnapshot[K comparable, V any] struct {
+	root *hamtNode[K, V]
+	size int
+	seed maphash.Seed
+}
+
+const (
+	hamtBits = 5
+	hamtMask = 1<<hamtBits - 1
+)
+
+type hamtEntry[K comparable, V any] struct {
+	key   K
+	value V
+	hash  uint64
+}
+
+type hamtChild[K comparable, V any] struct {
+	entry *hamtEntry[K, V]
+	node  *hamtNode[K, V]
+}
+
+type hamtNode[K comparable, V any] struct {
+	bitmap   uint32
+	children []hamtChild[K, V]
+	// collisions holds entries whose full 64-bit hashes are equal; only used below the last level.
+	collisions []*hamtEntry[K, V]
+}
+
+func NewSnapshotMap[K comparable, V any]() *SnapshotMap[K, V] {
+	m := &SnapshotMap[K, V]{}
+	m.current.Store(&MapSnapshot[K, V]{root: &hamtNode[K, V]{}, seed: maphash.MakeSeed()})
+	return m
+}
+
+func (m *SnapshotMap[K, V]) Snapshot() *MapSnapshot[K, V] {
+	return m.current.Load()
+}
+
+func (m *SnapshotMap[K, V]) Get(key K) (V, bool) {
+	return m.Snapshot().Get(key)
+}
+
+func (m *SnapshotMap[K, V]) Len() int {
+	return m.Snapshot().Len()
+}
+
+func (m *SnapshotMap[K, V]) Set(key K, value V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	snapshot := m.current.Load()
+	entry := &hamtEntry[K, V]{key: key, value: value, hash: maphash.Comparable(snapshot.seed, key)}
+	root, added := snapshot.root.insert(entry, 0)
+	size := snapshot.size
+	if added {
+		size++
+	}
+	m.current.Store(&MapSnapshot[K, V]{root: root, size: size, seed: snapshot.seed})
+}
+
+func (m *SnapshotMap[K, V]) Delete(key K) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	snapshot := m.current.Load()
+	root, removed := snapshot.root.remove(key, maphash.Comparable(snapshot.seed, key), 0)
+	if removed {
+		m.current.Store(&MapSnapshot[K, V]{root: root, size: snapshot.size - 1, seed: snapshot.seed})
+	}
+	return removed
+}
+
+func (s *MapSnapshot[K, V]) Len() int {
+	return s.size
+}
+
+func (s *MapSnapshot[K, V]) Get(key K) (V, bool) {
+	hash := maphash.Comparable(s.seed, key)
+	node := s.root
+	for shift := 0; ; shift += hamtBits {
+		if shift >= 64 {
+			for _, entry := range node.collisions {
+				if entry.key == key {
+					return entry.value, true
+				}
+			}
+			break
+		}
+		bit, pos := node.slot(hash, shift)
+		if node.bitmap&bit == 0 {
+			break
+		}
+		child := node.children[pos]
+		if child.entry != nil {
+			if child.entry.key == key {
+				return child.entry.value, true
+			}
+			break
+		}
+		node = child.node
+	}
+	var zero V
+	return zero, false
+}
+
+// All yields the entries in an unspecified but, for a given snapshot, repeatable order.
+func (s *MapSnapshot[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		s.root.walk(yield)
+	}
+}
+
+func (s *MapSnapshot[K, V]) ToMap() map[K]V {
+	result := make(map[K]V, s.size)
+	for key, value := range s.All() {
+		result[key] = value
+	}
+	return result
+}
+
+func (n *hamtNode[K, V]) slot(hash uint64, shift int) (uint32, int) {
+	bit := uint32(1) << ((hash >> shift) & hamtMask)
+	return bit, bits.OnesCount32(n.bitmap & (bit - 1))
+}
+
+func (n *hamtNode[K, V]) walk(yield func(K, V) bool) bool {
+	for _, entry := range n.collisions {
+		if !yield(entry.key, entry.value) {
+			return false
+		}
+	}
+	for _, child := range n.children {
+		if child.entry != nil {
+			if !yield(child.entry.key, child.entry.value) {
+				return false
+			}
+		} else if !child.node.walk(yield) {
+			return false
+		}
+	}
+	return true
+}
+
+func (n *hamtNode[K, V]) insert(entry *hamtEntry[K, V], shift int) (*hamtNode[K, V], bool) {
+	if shift >= 64 {
+		collisions := make([]*hamtEntry[K, V], 0, len(n.collisions)+1)
+		added := true
+		for _, existing := range n.collisions {
+			if existing.key == entry.key {
+				existing, added = entry, false
+			}
+			collisions = append(collisions, existing)
+		}
+		if added {
+			collisions = append(collisions, entry)
+		}
+		return &hamtNode[K, V]{collisions: collisions}, added
+	}
+	bit, pos := n.slot(entry.hash, shift)
+	if n.bitmap&bit == 0 {
+		children := make([]hamtChild[K, V], 0, len(n.children)+1)
+		children = append(children, n.children[:pos]...)
+		children = append(children, hamtChild[K, V]{entry: entry})
+		children = append(children, n.children[pos:]...)
+		return &hamtNode[K, V]{bitmap: n.bitmap | bit, children: children}, true
+	}
+	child := n.children[pos]
+	added := false
+	switch {
+	case child.node != nil:
+		child.node, added = child.node.insert(entry, shift+hamtBits)
+	case child.entry.key == entry.key:
+		child.entry = entry
+	default:
+		child = hamtChild[K, V]{node: newHamtPair(child.entry, entry, shift+hamtBits)}
+		added = true
+	}
+	return n.withChild(pos, child), added
+}
+
+func newHamtPair[K comparable, V any](a, b *hamtEntry[K, V], shift int) *hamtNode[K, V] {
+	if shift >= 64 {
+		return &hamtNode[K, V]{collisions: []*hamtEntry[K, V]{a, b}}
+	}
+	indexA, indexB := (a.hash>>shift)&hamtMask, (b.hash>>shift)&hamtMask
+	if indexA == indexB {
+		return &hamtNode[K, V]{
+			bitmap:   1 << indexA,
+			children: []hamtChild[K, V]{{node: newHamtPair(a, b, shift+hamtBits)}},
+		}
+	}
+	if indexA > indexB {
+		a, b = b, a
+	}
+	return &hamtNode[K, V]{
+		bitmap:   1<<indexA | 1<<indexB,
+		children: []hamtChild[K, V]{{entry: a}, {entry: b}},
+	}
+}
+
+func (n *hamtNode[K, V]) remove(key K, hash uint64, shift int) (*hamtNode[K, V], bool) {
+	if shift >= 64 {
+		index := -1
+		for i, entry := range n.collisions {
+			if entry.key == key {
+				index = i
+			}
+		}
+		if index < 0 {
+			return n, false
+		}
+		collisions := make([]*hamtEntry[K, V], 0, len(n.collisions)-1)
+		collisions = append(collisions, n.collisions[:index]...)
+		collisions = append(collisions, n.collisions[index+1:]...)
+		return &hamtNode[K, V]{collisions: collisions}, true
+	}
+	bit, pos := n.slot(hash, shift)
+	if n.bitmap&bit == 0 {
+		return n, false
+	}
+	child := n.children[pos]
+	if child.entry != nil {
+		if child.entry.key != key {
+			return n, false
+		}
+		return n.withoutChild(pos, bit), true
+	}
+	node, removed := child.node.remove(key, hash, shift+hamtBits)
+	if !removed {
+		return n, false
+	}
+	switch only := node.singleEntry(); {
+	case len(node.children) == 0 && len(node.collisions) == 0:
+		return n.withoutChild(pos, bit), true
+	case only != nil:
+		return n.withChild(pos, hamtChild[K, V]{entry: only}), true
+	default:
+		return n.withChild(pos, hamtChild[K, V]{node: node}), true
+	}
+}
+
+// singleEntry returns the node's entry when it holds exactly one, so it can be pulled up a level.
+func (n *hamtNode[K, V]) singleEntry() *hamtEntry[K, V] {
+	if len(n.collisions) == 1 && len(n.children) == 0 {
+		return n.collisions[0]
+	}
+	if len(n.children) == 1 && len(n.collisions) == 0 {
+		return n.children[0].entry
+	}
+	return nil
+}
+
+func (n *hamtNode[K, V]) withChild(pos int, child hamtChild[K, V]) *hamtNode[K, V] {
+	children := make([]hamtChild[K, V], len(n.children))
+	copy(children, n.children)
+	children[pos] = child
+	return &hamtNode[K, V]{bitmap: n.bitmap, children: children}
+}
+
+func (n *hamtNode[K, V]) withoutChild(pos int, bit uint32) *hamtNode[K, V] {
+	children := make([]hamtChild[K, V], 0, len(n.children)-1)
+	children = append(children, n.children[:pos]...)
+	children = append(children, n.children[pos+1:]...)
+	return &hamtNode[K, V]{bitmap: n.bitmap &^ bit, children: children}
+}
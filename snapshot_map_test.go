@@ -0,0 +1,123 @@
+package godelin
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestSnapshotMapMatchesBuiltinMap(t *testing.T) {
+	rng := rand.New(rand.NewPCG(7, 7))
+	m := NewSnapshotMap[int, int]()
+	expected := map[int]int{}
+	for i := range 5000 {
+		key := rng.IntN(500)
+		if rng.IntN(3) == 0 {
+			_, exists := expected[key]
+			if removed := m.Delete(key); removed != exists {
+				t.Fatalf("Delete(%d) = %v, expected %v", key, removed, exists)
+			}
+			delete(expected, key)
+		} else {
+			m.Set(key, i)
+			expected[key] = i
+		}
+	}
+	if m.Len() != len(expected) {
+		t.Errorf("Len() = %d, expected %d", m.Len(), len(expected))
+	}
+	if result := m.Snapshot().ToMap(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("ToMap() differs from the reference map")
+	}
+	for key := range 500 {
+		value, ok := m.Get(key)
+		expectedValue, expectedOK := expected[key]
+		if value != expectedValue || ok != expectedOK {
+			t.Errorf("Get(%d) = %d, %v, expected %d, %v", key, value, ok, expectedValue, expectedOK)
+		}
+	}
+}
+
+func TestSnapshotMapSnapshotIsolation(t *testing.T) {
+	m := NewSnapshotMap[string, int]()
+	m.Set("a", 1)
+	m.Set("b", 2)
+	snapshot := m.Snapshot()
+	m.Set("a", 10)
+	m.Set("c", 3)
+	m.Delete("b")
+	if result, expected := snapshot.ToMap(), map[string]int{"a": 1, "b": 2}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("snapshot = %v, expected %v", result, expected)
+	}
+	if result, expected := m.Snapshot().ToMap(), map[string]int{"a": 10, "c": 3}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("current = %v, expected %v", result, expected)
+	}
+}
+
+func TestSnapshotMapAllStopsEarly(t *testing.T) {
+	m := NewSnapshotMap[int, int]()
+	for i := range 100 {
+		m.Set(i, i)
+	}
+	count := 0
+	for range m.Snapshot().All() {
+		count++
+		if count == 3 {
+			break
+		}
+	}
+	if count != 3 {
+		t.Errorf("expected iteration to stop after 3 entries, got %d", count)
+	}
+}
+
+func TestHamtFullHashCollisions(t *testing.T) {
+	root := &hamtNode[string, int]{}
+	for i, key := range []string{"a", "b", "c"} {
+		var added bool
+		root, added = root.insert(&hamtEntry[string, int]{key: key, value: i, hash: 42}, 0)
+		if !added {
+			t.Fatalf("insert(%q) reported an update", key)
+		}
+	}
+	root, added := root.insert(&hamtEntry[string, int]{key: "b", value: 10, hash: 42}, 0)
+	if added {
+		t.Errorf("insert(b) reported an addition for an existing key")
+	}
+	snapshot := &MapSnapshot[string, int]{root: root}
+	if result, expected := snapshot.ToMap(), map[string]int{"a": 0, "b": 10, "c": 2}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("collision node = %v, expected %v", result, expected)
+	}
+	for _, key := range []string{"a", "c"} {
+		root, _ = root.remove(key, 42, 0)
+	}
+	if only := root.singleEntry(); only == nil || only.key != "b" {
+		t.Errorf("remaining entry was not pulled up to the root")
+	}
+}
+
+func TestSnapshotMapConcurrentReaders(t *testing.T) {
+	m := NewSnapshotMap[int, int]()
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 200 {
+				snapshot := m.Snapshot()
+				count := 0
+				for range snapshot.All() {
+					count++
+				}
+				if count != snapshot.Len() {
+					t.Errorf("snapshot yielded %d entries, Len() = %d", count, snapshot.Len())
+				}
+			}
+		}()
+	}
+	for i := range 1000 {
+		m.Set(i%50, i)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,108 @@
+package godelin
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// DistinctFold returns the first occurrence of each string, treating strings that are equal
+// under strings.EqualFold as duplicates.
+func DistinctFold(slice []string) []string {
+	return DistinctBy(slice, foldKey)
+}
+
+func ContainsFold(slice []string, s string) bool {
+	return slices.ContainsFunc(slice, func(element string) bool { return strings.EqualFold(element, s) })
+}
+
+// foldKey maps every rune to the smallest rune in its simple case-folding orbit, which gives
+// equal keys exactly for strings that strings.EqualFold considers equal.
+func foldKey(s string) string {
+	return strings.Map(func(r rune) rune {
+		smallest := r
+		for folded := unicode.SimpleFold(r); folded != r; folded = unicode.SimpleFold(folded) {
+			smallest = min(smallest, folded)
+		}
+		return smallest
+	}, s)
+}
+
+// FilterGlob returns the strings matching pattern. Patterns use path.Match syntax per
+// '/'-separated segment, and a "**" segment additionally matches any number of segments.
+func FilterGlob(slice []string, pattern string) ([]string, error) {
+	segments, err := compileGlob(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return Filter(slice, func(s string) bool { return matchGlob(segments, strings.Split(s, "/")) }), nil
+}
+
+func FilterKeysGlob[M ~map[string]V, V any](m M, pattern string) (M, error) {
+	segments, err := compileGlob(pattern)
+	if err != nil {
+		return nil, err
+	}
+	result := make(M)
+	for key, value := range m {
+		if matchGlob(segments, strings.Split(key, "/")) {
+			result[key] = value
+		}
+	}
+	return result, nil
+}
+
+func compileGlob(pattern string) ([]string, error) {
+	segments := strings.Split(pattern, "/")
+	for _, segment := range segments {
+		if _, err := path.Match(segment, ""); err != nil {
+			return nil, fmt.Errorf("pattern %q: %w", pattern, err)
+		}
+	}
+	return segments, nil
+}
+
+func matchGlob(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for skip := 0; skip <= len(name); skip++ {
+				if matchGlob(pattern[1:], name[skip:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(name) == 0 {
+			return false
+		}
+		if matched, _ := path.Match(pattern[0], name[0]); !matched {
+			return false
+		}
+		pattern, name = pattern[1:], name[1:]
+	}
+	return len(name) == 0
+}
+
+// ExtractAll returns every non-overlapping match of re across the strings, in order.
+func ExtractAll(slice []string, re *regexp.Regexp) []string {
+	result := make([]string, 0)
+	for _, s := range slice {
+		result = append(result, re.FindAllString(s, -1)...)
+	}
+	return result
+}
+
+// MapSubmatches calls transform with the submatches of the first match of re in each string,
+// where index 0 is the whole match; strings that do not match are skipped.
+func MapSubmatches[T any](slice []string, re *regexp.Regexp, transform func([]string) T) []T {
+	result := make([]T, 0)
+	for _, s := range slice {
+		if submatches := re.FindStringSubmatch(s); submatches != nil {
+			result = append(result, transform(submatches))
+		}
+	}
+	return result
+}
@@ -0,0 +1,123 @@
+package godelin
+
+import (
+	"errors"
+	"path"
+	"reflect"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+func TestDistinctFold(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []string
+		expected []string
+	}{
+		{"empty", []string{}, []string{}},
+		{"keeps first spelling", []string{"Go", "go", "GO", "Rust", "rust"}, []string{"Go", "Rust"}},
+		{"unicode folding", []string{"Straße", "STRASSE", "straße", "Σίσυφος", "ΣΊΣΥΦΟΣ"}, []string{"Straße", "STRASSE", "Σίσυφος"}},
+		{"kelvin sign", []string{"k", "K", "K"}, []string{"k"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := DistinctFold(tt.input); !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("DistinctFold() = %v, expected %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestContainsFold(t *testing.T) {
+	names := []string{"Alice", "Bob"}
+	if !ContainsFold(names, "ALICE") {
+		t.Errorf("ContainsFold(ALICE) = false, expected true")
+	}
+	if ContainsFold(names, "Carol") {
+		t.Errorf("ContainsFold(Carol) = true, expected false")
+	}
+	if ContainsFold(nil, "") {
+		t.Errorf("ContainsFold(nil) = true, expected false")
+	}
+}
+
+func TestFilterGlob(t *testing.T) {
+	paths := []string{"main.go", "main_test.go", "cmd/tool/main.go", "docs/readme.md", "a.b", "ab"}
+	tests := []struct {
+		pattern  string
+		expected []string
+	}{
+		{"*.go", []string{"main.go", "main_test.go"}},
+		{"*_test.go", []string{"main_test.go"}},
+		{"a?b", []string{"a.b"}},
+		{"cmd/*/main.go", []string{"cmd/tool/main.go"}},
+		{"**/*.go", []string{"main.go", "main_test.go", "cmd/tool/main.go"}},
+		{"**/readme.md", []string{"docs/readme.md"}},
+		{"docs/**", []string{"docs/readme.md"}},
+		{"[ab]*", []string{"a.b", "ab"}},
+		{"*.rs", []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			result, err := FilterGlob(paths, tt.pattern)
+			if err != nil {
+				t.Fatalf("FilterGlob() error = %v", err)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("FilterGlob() = %v, expected %v", result, tt.expected)
+			}
+		})
+	}
+
+	if _, err := FilterGlob(paths, "src/[a-"); !errors.Is(err, path.ErrBadPattern) {
+		t.Errorf("FilterGlob() error = %v, expected %v", err, path.ErrBadPattern)
+	}
+}
+
+func TestFilterKeysGlob(t *testing.T) {
+	settings := map[string]int{"db.host": 1, "db.port": 2, "cache.port": 3, "db/replica/port": 4}
+	result, err := FilterKeysGlob(settings, "*.port")
+	if err != nil {
+		t.Fatalf("FilterKeysGlob() error = %v", err)
+	}
+	if expected := map[string]int{"db.port": 2, "cache.port": 3}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("FilterKeysGlob() = %v, expected %v", result, expected)
+	}
+
+	result, _ = FilterKeysGlob(settings, "db/**")
+	if expected := map[string]int{"db/replica/port": 4}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("FilterKeysGlob() = %v, expected %v", result, expected)
+	}
+
+	if _, err := FilterKeysGlob(settings, "[["); !errors.Is(err, path.ErrBadPattern) {
+		t.Errorf("FilterKeysGlob() error = %v, expected %v", err, path.ErrBadPattern)
+	}
+}
+
+func TestExtractAll(t *testing.T) {
+	lines := []string{"GET /a 200 /b", "no paths", "POST /c 500"}
+	if result, expected := ExtractAll(lines, regexp.MustCompile(`/\w+`)), []string{"/a", "/b", "/c"}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("ExtractAll() = %v, expected %v", result, expected)
+	}
+	if result := ExtractAll(lines, regexp.MustCompile(`\d{4}`)); !reflect.DeepEqual(result, []string{}) {
+		t.Errorf("ExtractAll() = %v, expected []", result)
+	}
+}
+
+func TestMapSubmatches(t *testing.T) {
+	type request struct {
+		Method string
+		Status int
+	}
+	lines := []string{"GET /a 200", "garbage", "POST /c 500 GET /d 404"}
+	re := regexp.MustCompile(`(GET|POST) \S+ (\d{3})`)
+	result := MapSubmatches(lines, re, func(m []string) request {
+		status, _ := strconv.Atoi(m[2])
+		return request{Method: m[1], Status: status}
+	})
+	expected := []request{{"GET", 200}, {"POST", 500}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("MapSubmatches() = %v, expected %v", result, expected)
+	}
+}
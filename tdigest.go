@@ -0,0 +1,138 @@
+package godelin
+
+import (
+	"cmp"
+	"math"
+	"slices"
+)
+
+// TDigest estimates quantiles of a stream in bounded memory. Values are buffered and
+// periodically merged into weighted centroids; centroids near the median may absorb many
+// values while those near the tails stay small, so extreme quantiles remain accurate.
+// Compression trades memory for accuracy: the digest keeps on the order of compression
+// centroids, and 100 is a reasonable default.
+type TDigest struct {
+	compression float64
+	centroids   []centroid
+	buffer      []centroid
+	count       float64
+	min         float64
+	max         float64
+}
+
+type centroid struct {
+	mean   float64
+	weight float64
+}
+
+func NewTDigest(compression float64) *TDigest {
+	if compression <= 0 {
+		panic("NewTDigest: compression must be positive")
+	}
+	return &TDigest{compression: compression, min: math.Inf(1), max: math.Inf(-1)}
+}
+
+// Add records value; NaN values are ignored.
+func (d *TDigest) Add(value float64) {
+	if math.IsNaN(value) {
+		return
+	}
+	d.add(centroid{mean: value, weight: 1}, value, value)
+}
+
+func (d *TDigest) add(c centroid, low, high float64) {
+	d.buffer = append(d.buffer, c)
+	d.count += c.weight
+	d.min = min(d.min, low)
+	d.max = max(d.max, high)
+	if len(d.buffer) >= d.bufferLimit() {
+		d.flush()
+	}
+}
+
+func (d *TDigest) bufferLimit() int {
+	return max(16, int(5*d.compression))
+}
+
+func (d *TDigest) Count() int {
+	return int(d.count)
+}
+
+// Merge adds everything recorded by other into d; other is left unchanged.
+func (d *TDigest) Merge(other *TDigest) {
+	if other.count == 0 {
+		return
+	}
+	for _, c := range slices.Concat(other.centroids, other.buffer) {
+		d.add(c, other.min, other.max)
+	}
+}
+
+func (d *TDigest) flush() {
+	if len(d.buffer) == 0 {
+		return
+	}
+	all := append(d.centroids, d.buffer...)
+	slices.SortFunc(all, func(a, b centroid) int { return cmp.Compare(a.mean, b.mean) })
+	merged := make([]centroid, 0, len(d.centroids)+1)
+	current := all[0]
+	var before float64
+	limit := d.quantileLimit(0)
+	for _, next := range all[1:] {
+		combined := current.weight + next.weight
+		if (before+combined)/d.count <= limit {
+			current.mean += (next.mean - current.mean) * next.weight / combined
+			current.weight = combined
+			continue
+		}
+		before += current.weight
+		merged = append(merged, current)
+		current = next
+		limit = d.quantileLimit(before / d.count)
+	}
+	d.centroids = append(merged, current)
+	d.buffer = d.buffer[:0]
+}
+
+// quantileLimit returns how far past q a centroid starting at q may extend. It uses the scale
+// function k(q) = compression/π·asin(2q-1), allowing each centroid one unit of k, which is
+// what keeps tail centroids small.
+func (d *TDigest) quantileLimit(q float64) float64 {
+	angle := math.Asin(2*q-1) + math.Pi/d.compression
+	if angle >= math.Pi/2 {
+		return 1
+	}
+	return (math.Sin(angle) + 1) / 2
+}
+
+// Quantile returns the estimated value at q, interpolating between centroid means, or NaN
+// when nothing has been added. q must be within [0, 1].
+func (d *TDigest) Quantile(q float64) float64 {
+	if q < 0 || q > 1 {
+		panic("TDigest.Quantile: q must be within [0, 1]")
+	}
+	d.flush()
+	if d.count == 0 {
+		return math.NaN()
+	}
+	target := q * d.count
+	// Interpolate on the cumulative weight at each centroid's center, anchored at min and max.
+	previousMean, previousRank := d.min, 0.0
+	var cumulative float64
+	for _, c := range d.centroids {
+		rank := cumulative + c.weight/2
+		if target < rank {
+			return interpolate(previousMean, c.mean, previousRank, rank, target)
+		}
+		previousMean, previousRank = c.mean, rank
+		cumulative += c.weight
+	}
+	return interpolate(previousMean, d.max, previousRank, d.count, target)
+}
+
+func interpolate(low, high, lowRank, highRank, target float64) float64 {
+	if highRank == lowRank {
+		return low
+	}
+	return low + (high-low)*(target-lowRank)/(highRank-lowRank)
+}
@@ -0,0 +1,89 @@
+package godelin
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func exactQuantile(sorted []float64, q float64) float64 {
+	return sorted[int(q*float64(len(sorted)-1))]
+}
+
+func TestTDigestQuantiles(t *testing.T) {
+	rng := rand.New(rand.NewPCG(3, 3))
+	digest := NewTDigest(100)
+	values := make([]float64, 100_000)
+	for i := range values {
+		values[i] = rng.NormFloat64()*10 + 50
+		digest.Add(values[i])
+	}
+	slices.Sort(values)
+	for _, q := range []float64{0.001, 0.01, 0.1, 0.5, 0.9, 0.99, 0.999} {
+		estimate, exact := digest.Quantile(q), exactQuantile(values, q)
+		if math.Abs(estimate-exact) > 0.5 {
+			t.Errorf("Quantile(%v) = %v, exact value is %v", q, estimate, exact)
+		}
+	}
+	if got := digest.Quantile(0); got != values[0] {
+		t.Errorf("Quantile(0) = %v, expected the minimum %v", got, values[0])
+	}
+	if got := digest.Quantile(1); got != values[len(values)-1] {
+		t.Errorf("Quantile(1) = %v, expected the maximum %v", got, values[len(values)-1])
+	}
+	if digest.Count() != len(values) {
+		t.Errorf("Count() = %d, expected %d", digest.Count(), len(values))
+	}
+	if len(digest.centroids) > 200 {
+		t.Errorf("digest kept %d centroids, expected memory bounded by compression", len(digest.centroids))
+	}
+}
+
+func TestTDigestMerge(t *testing.T) {
+	rng := rand.New(rand.NewPCG(4, 4))
+	whole, left, right := NewTDigest(100), NewTDigest(100), NewTDigest(100)
+	for i := range 20_000 {
+		value := rng.Float64() * 1000
+		whole.Add(value)
+		if i%2 == 0 {
+			left.Add(value)
+		} else {
+			right.Add(value)
+		}
+	}
+	left.Merge(right)
+	if left.Count() != whole.Count() {
+		t.Errorf("merged Count() = %d, expected %d", left.Count(), whole.Count())
+	}
+	for _, q := range []float64{0.05, 0.25, 0.5, 0.75, 0.95} {
+		if merged, direct := left.Quantile(q), whole.Quantile(q); math.Abs(merged-direct) > 5 {
+			t.Errorf("merged Quantile(%v) = %v, single digest gives %v", q, merged, direct)
+		}
+	}
+}
+
+func TestTDigestSmallInputs(t *testing.T) {
+	digest := NewTDigest(100)
+	if got := digest.Quantile(0.5); !math.IsNaN(got) {
+		t.Errorf("Quantile() of empty digest = %v, expected NaN", got)
+	}
+	digest.Add(7)
+	digest.Add(math.NaN())
+	if got := digest.Quantile(0.5); got != 7 {
+		t.Errorf("Quantile() of a single value = %v, expected 7", got)
+	}
+	for _, value := range []float64{1, 2, 3} {
+		digest.Add(value)
+	}
+	if got := digest.Quantile(0.5); got < 2 || got > 3 {
+		t.Errorf("Quantile(0.5) of [1 2 3 7] = %v, expected a value between 2 and 3", got)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected a panic but did not get one")
+		}
+	}()
+	digest.Quantile(1.5)
+}
@@ -0,0 +1,80 @@
+package godelin
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+var (
+	ErrUnknownDependency = errors.New("unknown dependency")
+	ErrDependencyCycle   = errors.New("dependency cycle")
+)
+
+// TopologicalSort orders items so that every item comes after the items it depends on. Among
+// items whose dependencies are satisfied, the one earliest in items goes first, so an input
+// that is already in a valid order is returned unchanged. It fails with ErrDuplicateKey,
+// ErrUnknownDependency, or ErrDependencyCycle naming the items on one cycle as "a -> b -> a",
+// where each item depends on the next.
+func TopologicalSort[T any, K comparable](items []T, deps func(T) []K, id func(T) K) ([]T, error) {
+	positions, err := IndexBy(Iterate(len(items), func(i int) int { return i }), func(i int) K { return id(items[i]) })
+	if err != nil {
+		return nil, err
+	}
+	pending := make([]int, len(items))
+	dependents := make([][]int, len(items))
+	for i, item := range items {
+		for _, dependency := range deps(item) {
+			position, exists := positions[dependency]
+			if !exists {
+				return nil, fmt.Errorf("%v: %w %v", id(item), ErrUnknownDependency, dependency)
+			}
+			pending[i]++
+			dependents[position] = append(dependents[position], i)
+		}
+	}
+
+	ready := NewPriorityQueue(func(a, b int) bool { return a < b })
+	for i, count := range pending {
+		if count == 0 {
+			ready.Push(i)
+		}
+	}
+	result := make([]T, 0, len(items))
+	for ready.Len() > 0 {
+		next, _ := ready.Pop()
+		result = append(result, items[next])
+		for _, dependent := range dependents[next] {
+			if pending[dependent]--; pending[dependent] == 0 {
+				ready.Push(dependent)
+			}
+		}
+	}
+	if len(result) < len(items) {
+		return nil, fmt.Errorf("%w: %s", ErrDependencyCycle, describeCycle(items, deps, id, positions, pending))
+	}
+	return result, nil
+}
+
+// describeCycle follows unsatisfied dependencies from a blocked item. Every blocked item has at
+// least one blocked dependency, so the walk must revisit an item, closing a cycle.
+func describeCycle[T any, K comparable](items []T, deps func(T) []K, id func(T) K, positions map[K]int, pending []int) string {
+	current := slices.IndexFunc(pending, func(count int) bool { return count > 0 })
+	visitedAt := make(map[int]int)
+	path := make([]int, 0)
+	for {
+		if start, visited := visitedAt[current]; visited {
+			cycle := append(path[start:], current)
+			return strings.Join(Map(cycle, func(i int) string { return fmt.Sprint(id(items[i])) }), " -> ")
+		}
+		visitedAt[current] = len(path)
+		path = append(path, current)
+		for _, dependency := range deps(items[current]) {
+			if position := positions[dependency]; pending[position] > 0 {
+				current = position
+				break
+			}
+		}
+	}
+}
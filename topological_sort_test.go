@@ -0,0 +1,71 @@
+package godelin
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type migration struct {
+	name     string
+	requires []string
+}
+
+func migrationDeps(m migration) []string { return m.requires }
+func migrationName(m migration) string   { return m.name }
+
+func TestTopologicalSort(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []migration
+		expected []string
+	}{
+		{"empty", []migration{}, []string{}},
+		{"already ordered", []migration{{"a", nil}, {"b", []string{"a"}}, {"c", []string{"b"}}}, []string{"a", "b", "c"}},
+		{"reversed", []migration{{"c", []string{"b"}}, {"b", []string{"a"}}, {"a", nil}}, []string{"a", "b", "c"}},
+		{
+			"diamond keeps input order among ready items",
+			[]migration{{"users", nil}, {"audit", []string{"orders", "users"}}, {"orders", []string{"users"}}, {"tags", nil}},
+			[]string{"users", "orders", "audit", "tags"},
+		},
+		{"repeated dependency", []migration{{"b", []string{"a", "a"}}, {"a", nil}}, []string{"a", "b"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sorted, err := TopologicalSort(tt.input, migrationDeps, migrationName)
+			if err != nil {
+				t.Fatalf("TopologicalSort() error = %v", err)
+			}
+			if names := Map(sorted, migrationName); !reflect.DeepEqual(names, tt.expected) {
+				t.Errorf("TopologicalSort() = %v, expected %v", names, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTopologicalSortErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []migration
+		sentinel error
+		message  string
+	}{
+		{
+			"cycle",
+			[]migration{{"root", nil}, {"a", []string{"root", "c"}}, {"b", []string{"a"}}, {"c", []string{"b"}}, {"d", []string{"c"}}},
+			ErrDependencyCycle,
+			"dependency cycle: a -> c -> b -> a",
+		},
+		{"self dependency", []migration{{"a", []string{"a"}}}, ErrDependencyCycle, "dependency cycle: a -> a"},
+		{"unknown dependency", []migration{{"a", []string{"missing"}}}, ErrUnknownDependency, "a: unknown dependency missing"},
+		{"duplicate id", []migration{{"a", nil}, {"a", nil}}, ErrDuplicateKey, "item 1: duplicate key a (first seen at item 0)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sorted, err := TopologicalSort(tt.input, migrationDeps, migrationName)
+			if sorted != nil || !errors.Is(err, tt.sentinel) || err.Error() != tt.message {
+				t.Errorf("TopologicalSort() = %v, %v, expected nil, %q", sorted, err, tt.message)
+			}
+		})
+	}
+}
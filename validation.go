@@ -0,0 +1,61 @@
+package godelin
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrMissingKey   = errors.New("missing key")
+	ErrDuplicateKey = errors.New("duplicate key")
+)
+
+func ValidateEach[T any](slice []T, rules ...func(T) error) error {
+	errs := Map(slice, func(element T) error {
+		return errors.Join(Map(rules, func(rule func(T) error) error { return rule(element) })...)
+	})
+	return JoinWithIndices(errs)
+}
+
+func RequireKeys[M ~map[K]V, K comparable, V any](m M, keys ...K) error {
+	errs := make([]error, 0)
+	for _, key := range keys {
+		if _, exists := m[key]; !exists {
+			errs = append(errs, fmt.Errorf("%w %v", ErrMissingKey, key))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func RequireUnique[T any, K comparable](slice []T, keySelector func(T) K) error {
+	_, err := firstIndexByKey(slice, keySelector)
+	return err
+}
+
+// IndexBy maps every element by its key. Unlike Associate, where the last element silently wins,
+// colliding keys are reported as ErrDuplicateKey errors in the same form as RequireUnique.
+func IndexBy[T any, K comparable](slice []T, keySelector func(T) K) (map[K]T, error) {
+	firstSeen, err := firstIndexByKey(slice, keySelector)
+	if err != nil {
+		return nil, err
+	}
+	index := make(map[K]T, len(firstSeen))
+	for key, i := range firstSeen {
+		index[key] = slice[i]
+	}
+	return index, nil
+}
+
+func firstIndexByKey[T any, K comparable](slice []T, keySelector func(T) K) (map[K]int, error) {
+	firstSeen := make(map[K]int, len(slice))
+	errs := make([]error, len(slice))
+	for i, element := range slice {
+		key := keySelector(element)
+		if first, exists := firstSeen[key]; exists {
+			errs[i] = fmt.Errorf("%w %v (first seen at item %d)", ErrDuplicateKey, key, first)
+			continue
+		}
+		firstSeen[key] = i
+	}
+	return firstSeen, JoinWithIndices(errs)
+}
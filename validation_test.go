@@ -0,0 +1,106 @@
+package godelin
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+type signup struct {
+	email string
+	age   int
+}
+
+func requireEmail(s signup) error {
+	if s.email == "" {
+		return errors.New("email is required")
+	}
+	return nil
+}
+
+func requireAdult(s signup) error {
+	if s.age < 18 {
+		return fmt.Errorf("age %d is below 18", s.age)
+	}
+	return nil
+}
+
+func TestValidateEach(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    []signup
+		expected string
+	}{
+		{
+			name:     "all valid",
+			input:    []signup{{"a@x.io", 30}, {"b@x.io", 18}},
+			expected: "",
+		},
+		{
+			name:     "indexed failures",
+			input:    []signup{{"a@x.io", 30}, {"", 12}, {"c@x.io", 17}},
+			expected: "item 1: email is required\nage 12 is below 18\nitem 2: age 17 is below 18",
+		},
+		{
+			name:     "empty slice",
+			input:    []signup{},
+			expected: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := ValidateEach(testCase.input, requireEmail, requireAdult)
+			actual := ""
+			if err != nil {
+				actual = err.Error()
+			}
+			if actual != testCase.expected {
+				t.Errorf("ValidateEach() = %q, expected %q", actual, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestRequireKeys(t *testing.T) {
+	config := map[string]string{"host": "localhost", "port": "80"}
+	if err := RequireKeys(config, "host", "port"); err != nil {
+		t.Errorf("RequireKeys() = %v, expected nil", err)
+	}
+	err := RequireKeys(config, "user", "host", "password")
+	if !errors.Is(err, ErrMissingKey) || err.Error() != "missing key user\nmissing key password" {
+		t.Errorf("RequireKeys() = %v, expected missing user and password", err)
+	}
+}
+
+func TestRequireUnique(t *testing.T) {
+	input := []signup{{"a@x.io", 30}, {"b@x.io", 20}, {"a@x.io", 40}, {"a@x.io", 50}}
+	err := RequireUnique(input, func(s signup) string { return s.email })
+	expected := "item 2: duplicate key a@x.io (first seen at item 0)\nitem 3: duplicate key a@x.io (first seen at item 0)"
+	if !errors.Is(err, ErrDuplicateKey) || err.Error() != expected {
+		t.Errorf("RequireUnique() = %v, expected %q", err, expected)
+	}
+	if err := RequireUnique(input[:2], func(s signup) string { return s.email }); err != nil {
+		t.Errorf("RequireUnique() = %v, expected nil", err)
+	}
+}
+
+func TestIndexBy(t *testing.T) {
+	byEmail := func(s signup) string { return s.email }
+	input := []signup{{"a@x.io", 30}, {"b@x.io", 20}}
+	index, err := IndexBy(input, byEmail)
+	if expected := map[string]signup{"a@x.io": input[0], "b@x.io": input[1]}; err != nil || !reflect.DeepEqual(index, expected) {
+		t.Errorf("IndexBy() = %v, %v, expected %v, nil", index, err, expected)
+	}
+
+	index, err = IndexBy(append(input, signup{"a@x.io", 40}), byEmail)
+	expected := "item 2: duplicate key a@x.io (first seen at item 0)"
+	if index != nil || !errors.Is(err, ErrDuplicateKey) || err.Error() != expected {
+		t.Errorf("IndexBy() = %v, %v, expected nil, %q", index, err, expected)
+	}
+
+	if index, err := IndexBy([]signup{}, byEmail); err != nil || len(index) != 0 || index == nil {
+		t.Errorf("IndexBy([]) = %#v, %v, expected an empty map", index, err)
+	}
+}
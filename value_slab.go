@@ -0,0 +1,88 @@
+package godelin
+
+import "math"
+
+// valueSpan locates one key's values inside a valueSlab.
+type valueSpan struct {
+	start            int
+	length, capacity int32
+}
+
+// valueSlab stores the values of many keys in one shared slice, each key owning a span of it,
+// so a key with one or two values costs neither an allocation nor a slice header of its own.
+// Values below a span's length are never overwritten: growing a span past its capacity or
+// removing from it moves it to the end of the slab, so views handed out earlier stay valid.
+// The slots left behind are holes until the slab is compacted into a new array.
+type valueSlab[V any] struct {
+	values []V
+	holes  int
+}
+
+// view returns the span's values, capacity-capped so appending to them reallocates.
+func (s *valueSlab[V]) view(span valueSpan) []V {
+	end := span.start + int(span.length)
+	return s.values[span.start:end:end]
+}
+
+func (s *valueSlab[V]) appended(span valueSpan, values ...V) valueSpan {
+	length := int(span.length) + len(values)
+	if length > math.MaxInt32 {
+		panic("MultiMap: too many values under one key")
+	}
+	if length > int(span.capacity) {
+		// a new span has room for two values, since most keys get one or two; moving a span
+		// leaves a hole, so this is cheaper than growing from one. After that capacity doubles.
+		old := span
+		span = s.moved(s.view(old), min(max(length, 2*int(old.capacity), 2), math.MaxInt32))
+		s.release(old)
+	}
+	copy(s.values[span.start+int(span.length):], values)
+	span.length = int32(length)
+	return span
+}
+
+func (s *valueSlab[V]) removed(span valueSpan, i int) valueSpan {
+	values := s.view(span)
+	s.release(span)
+	moved := s.moved(values[:i], int(span.length)-1)
+	copy(s.values[moved.start+i:], values[i+1:])
+	moved.length = span.length - 1
+	return moved
+}
+
+// moved copies values into a new span of the given capacity at the end of the slab and leaves
+// the old slots behind. The caller releases the span the values came from.
+func (s *valueSlab[V]) moved(values []V, capacity int) valueSpan {
+	start := len(s.values)
+	if start+capacity > cap(s.values) {
+		// double rather than follow append's growth, which slows to 1.25x for large slices
+		// and would allocate several times the final slab size along the way
+		grown := make([]V, start, max(2*cap(s.values), start+capacity))
+		copy(grown, s.values)
+		s.values = grown
+	}
+	s.values = s.values[:start+capacity]
+	copy(s.values[start:], values)
+	return valueSpan{start: start, length: int32(len(values)), capacity: int32(capacity)}
+}
+
+func (s *valueSlab[V]) release(span valueSpan) {
+	s.holes += int(span.capacity)
+}
+
+// compactSlab copies every span into a fresh slab once holes make up more than half of it.
+// The old array is left untouched for the views still pointing into it.
+func compactSlab[K comparable, V any](s *valueSlab[V], spans map[K]valueSpan) {
+	if s.holes*2 <= len(s.values) {
+		return
+	}
+	values := make([]V, 0, len(s.values)-s.holes)
+	for key, span := range spans {
+		start := len(values)
+		values = append(values, s.view(span)...)
+		values = append(values, make([]V, span.capacity-span.length)...)
+		spans[key] = valueSpan{start: start, length: span.length, capacity: span.capacity}
+	}
+	s.values = values
+	s.holes = 0
+}
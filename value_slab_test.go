@@ -0,0 +1,59 @@
+package godelin
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestMultiMapSlabMatchesMapOfSlices(t *testing.T) {
+	rng := rand.New(rand.NewPCG(7, 7))
+	m := NewMultiMap[int, int]()
+	expected := make(map[int][]int)
+	type snapshot struct {
+		values, copied []int
+	}
+	snapshots := make([]snapshot, 0)
+	for step := range 20_000 {
+		key := rng.IntN(50)
+		switch rng.IntN(10) {
+		case 0, 1, 2:
+			value := rng.IntN(5)
+			removed := m.RemoveValue(key, value)
+			index := slices.Index(expected[key], value)
+			if removed != (index >= 0) {
+				t.Fatalf("step %d: RemoveValue(%d, %d) = %v, expected %v", step, key, value, removed, index >= 0)
+			}
+			if index >= 0 {
+				expected[key] = slices.Delete(slices.Clone(expected[key]), index, index+1)
+				if len(expected[key]) == 0 {
+					delete(expected, key)
+				}
+			}
+		case 3:
+			if got := m.RemoveKey(key); !reflect.DeepEqual(got, EmptyIfNil(expected[key])) {
+				t.Fatalf("step %d: RemoveKey(%d) = %v, expected %v", step, key, got, expected[key])
+			}
+			delete(expected, key)
+		case 4:
+			values := m.Get(key)
+			snapshots = append(snapshots, snapshot{values, slices.Clone(values)})
+		default:
+			values := Iterate(1+rng.IntN(3), func(int) int { return rng.IntN(5) })
+			m.Add(key, values...)
+			expected[key] = append(slices.Clone(expected[key]), values...)
+		}
+	}
+	if got := m.ToMap(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("ToMap() = %v, expected %v", got, expected)
+	}
+	for i, s := range snapshots {
+		if !reflect.DeepEqual(s.values, s.copied) {
+			t.Fatalf("Get() result %d changed from %v to %v", i, s.copied, s.values)
+		}
+	}
+	if live := m.Size(); len(m.slab.values) > 4*live+64 {
+		t.Errorf("slab holds %d slots for %d values, expected compaction to bound it", len(m.slab.values), live)
+	}
+}